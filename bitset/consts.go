@@ -0,0 +1,15 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package bitset
+
+import "errors"
+
+const (
+	wordSize     = 64 // Number of bits in a single word
+	log2WordSize = 6  // log2(wordSize), used to turn a bit index into a word index
+)
+
+// ErrInvalidEncoding is returned when binary data cannot be decoded into a bit set
+var ErrInvalidEncoding = errors.New("bitset: invalid binary encoding")
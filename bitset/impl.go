@@ -0,0 +1,286 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package bitset
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// New creates a new bit set able to hold at least length bits without growing
+func New(length uint) *BitSet {
+	return &BitSet{words: make([]uint64, wordsNeeded(length))}
+}
+
+// From creates a new bit set with the given bits set
+func From(indices ...uint) *BitSet {
+	b := &BitSet{}
+	for _, i := range indices {
+		b.Set(i)
+	}
+	return b
+}
+
+// Set sets bit i to 1, growing the bit set if i is beyond its current length
+func (b *BitSet) Set(i uint) {
+	b.grow(i)
+	b.words[i>>log2WordSize] |= 1 << (i & (wordSize - 1))
+}
+
+// Clear sets bit i to 0. Bits beyond the current length are already clear.
+func (b *BitSet) Clear(i uint) {
+	if i >= b.Len() {
+		return
+	}
+	b.words[i>>log2WordSize] &^= 1 << (i & (wordSize - 1))
+}
+
+// Flip toggles bit i, growing the bit set if i is beyond its current length
+func (b *BitSet) Flip(i uint) {
+	b.grow(i)
+	b.words[i>>log2WordSize] ^= 1 << (i & (wordSize - 1))
+}
+
+// Test reports whether bit i is set
+func (b *BitSet) Test(i uint) bool {
+	if i >= b.Len() {
+		return false
+	}
+	return b.words[i>>log2WordSize]&(1<<(i&(wordSize-1))) != 0
+}
+
+// NextSet returns the index of the first set bit at or after i
+// The second return value is false if there is no such bit
+func (b *BitSet) NextSet(i uint) (uint, bool) {
+	x := int(i >> log2WordSize)
+	if x >= len(b.words) {
+		return 0, false
+	}
+
+	// Mask out the bits below i in the first word
+	word := b.words[x] >> (i & (wordSize - 1))
+	if word != 0 {
+		return i + uint(bits.TrailingZeros64(word)), true
+	}
+
+	// Scan the remaining words one at a time
+	for x++; x < len(b.words); x++ {
+		if b.words[x] != 0 {
+			return uint(x)*wordSize + uint(bits.TrailingZeros64(b.words[x])), true
+		}
+	}
+	return 0, false
+}
+
+// NextClear returns the index of the first clear bit at or after i
+// Every index beyond the length of the bit set is clear, so the search always succeeds;
+// the boolean is kept for symmetry with NextSet.
+func (b *BitSet) NextClear(i uint) (uint, bool) {
+	x := int(i >> log2WordSize)
+	if x >= len(b.words) {
+		return i, true
+	}
+
+	// Invert the first word so that clear bits become set bits
+	word := ^b.words[x] >> (i & (wordSize - 1))
+	if word != 0 {
+		return i + uint(bits.TrailingZeros64(word)), true
+	}
+
+	// Scan the remaining words one at a time
+	for x++; x < len(b.words); x++ {
+		if b.words[x] != ^uint64(0) {
+			return uint(x)*wordSize + uint(bits.TrailingZeros64(^b.words[x])), true
+		}
+	}
+	return b.Len(), true
+}
+
+// Count returns the number of set bits (population count)
+func (b *BitSet) Count() uint {
+	var count int
+	for _, word := range b.words {
+		count += bits.OnesCount64(word)
+	}
+	return uint(count)
+}
+
+// Len returns the number of bits the set can hold without growing
+func (b *BitSet) Len() uint {
+	return uint(len(b.words)) * wordSize
+}
+
+// IsEmpty checks if no bit is set
+func (b *BitSet) IsEmpty() bool {
+	for _, word := range b.words {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ClearAll sets every bit to 0 but keeps the allocated length
+func (b *BitSet) ClearAll() {
+	for i := range b.words {
+		b.words[i] = 0
+	}
+}
+
+// Clone returns a copy of the bit set
+func (b *BitSet) Clone() *BitSet {
+	words := make([]uint64, len(b.words))
+	copy(words, b.words)
+	return &BitSet{words: words}
+}
+
+// Values returns the indices of all set bits in ascending order
+func (b *BitSet) Values() []uint {
+	values := make([]uint, 0, b.Count())
+	for x, word := range b.words {
+		// Repeatedly take the lowest set bit and clear it
+		for word != 0 {
+			values = append(values, uint(x)*wordSize+uint(bits.TrailingZeros64(word)))
+			word &= word - 1
+		}
+	}
+	return values
+}
+
+// And returns a new bit set holding the bits set in both b and other
+func (b *BitSet) And(other *BitSet) *BitSet {
+	result := b.Clone()
+	result.InPlaceAnd(other)
+	return result
+}
+
+// Or returns a new bit set holding the bits set in b or other
+func (b *BitSet) Or(other *BitSet) *BitSet {
+	result := b.Clone()
+	result.InPlaceOr(other)
+	return result
+}
+
+// Xor returns a new bit set holding the bits set in exactly one of b and other
+func (b *BitSet) Xor(other *BitSet) *BitSet {
+	result := b.Clone()
+	result.InPlaceXor(other)
+	return result
+}
+
+// AndNot returns a new bit set holding the bits set in b but not in other
+func (b *BitSet) AndNot(other *BitSet) *BitSet {
+	result := b.Clone()
+	result.InPlaceAndNot(other)
+	return result
+}
+
+// InPlaceAnd keeps only the bits of b that are also set in other
+func (b *BitSet) InPlaceAnd(other *BitSet) {
+	n := min(len(b.words), len(other.words))
+	for i := 0; i < n; i++ {
+		b.words[i] &= other.words[i]
+	}
+	// Words missing from other are all zero
+	for i := n; i < len(b.words); i++ {
+		b.words[i] = 0
+	}
+}
+
+// InPlaceOr sets every bit of b that is set in other, growing b if needed
+func (b *BitSet) InPlaceOr(other *BitSet) {
+	b.growWords(len(other.words))
+	for i, word := range other.words {
+		b.words[i] |= word
+	}
+}
+
+// InPlaceXor toggles every bit of b that is set in other, growing b if needed
+func (b *BitSet) InPlaceXor(other *BitSet) {
+	b.growWords(len(other.words))
+	for i, word := range other.words {
+		b.words[i] ^= word
+	}
+}
+
+// InPlaceAndNot clears every bit of b that is set in other
+func (b *BitSet) InPlaceAndNot(other *BitSet) {
+	n := min(len(b.words), len(other.words))
+	for i := 0; i < n; i++ {
+		b.words[i] &^= other.words[i]
+	}
+}
+
+// Equal checks if both bit sets hold exactly the same bits
+// Trailing unset words are ignored, so sets of different lengths can be equal.
+func (b *BitSet) Equal(other *BitSet) bool {
+	short, long := b.words, other.words
+	if len(short) > len(long) {
+		short, long = long, short
+	}
+	for i, word := range short {
+		if word != long[i] {
+			return false
+		}
+	}
+	for _, word := range long[len(short):] {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset checks if every bit set in other is also set in b
+func (b *BitSet) IsSuperset(other *BitSet) bool {
+	for i, word := range other.words {
+		var mine uint64
+		if i < len(b.words) {
+			mine = b.words[i]
+		}
+		if word&^mine != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns a string representation of the bit set
+func (b *BitSet) String() string {
+	// Create a slice to hold string representations of the set bits
+	var elements []string
+	for _, i := range b.Values() {
+		elements = append(elements, fmt.Sprintf("%d", i))
+	}
+
+	// Join all the elements with commas and wrap them in curly brackets
+	return fmt.Sprintf("BitSet elements: {%s}", strings.Join(elements, ", "))
+}
+
+// grow makes sure bit i fits into the bit set
+func (b *BitSet) grow(i uint) {
+	b.growWords(int(i>>log2WordSize) + 1)
+}
+
+// growWords makes sure the bit set holds at least n words
+func (b *BitSet) growWords(n int) {
+	if n <= len(b.words) {
+		return
+	}
+	if n <= cap(b.words) {
+		b.words = b.words[:n]
+		return
+	}
+	// Double the capacity to amortize repeated growth
+	words := make([]uint64, n, max(n, 2*cap(b.words)))
+	copy(words, b.words)
+	b.words = words
+}
+
+// wordsNeeded returns the number of words needed to hold length bits
+func wordsNeeded(length uint) int {
+	return int((length + wordSize - 1) >> log2WordSize)
+}
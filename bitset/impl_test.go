@@ -0,0 +1,215 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package bitset
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBitSet_New(t *testing.T) {
+	b := New(100)
+	if b.Len() != 128 {
+		t.Errorf("Expected length 128, got %d", b.Len())
+	}
+	if !b.IsEmpty() {
+		t.Errorf("Expected new bit set to be empty")
+	}
+
+	empty := New(0)
+	if empty.Len() != 0 {
+		t.Errorf("Expected length 0, got %d", empty.Len())
+	}
+}
+
+func TestBitSet_SetClearTest(t *testing.T) {
+	b := New(0)
+
+	// Setting a bit beyond the length grows the set
+	b.Set(3)
+	b.Set(200)
+	if !b.Test(3) || !b.Test(200) {
+		t.Errorf("Expected bits 3 and 200 to be set")
+	}
+	if b.Test(4) || b.Test(1000) {
+		t.Errorf("Expected bits 4 and 1000 to be clear")
+	}
+	if b.Len() < 201 {
+		t.Errorf("Expected length of at least 201, got %d", b.Len())
+	}
+
+	b.Clear(3)
+	if b.Test(3) {
+		t.Errorf("Expected bit 3 to be clear after Clear")
+	}
+
+	// Clearing a bit beyond the length is a no-op
+	b.Clear(5000)
+	if b.Count() != 1 {
+		t.Errorf("Expected count 1, got %d", b.Count())
+	}
+}
+
+func TestBitSet_Flip(t *testing.T) {
+	b := New(0)
+	b.Flip(70)
+	if !b.Test(70) {
+		t.Errorf("Expected bit 70 to be set after first Flip")
+	}
+	b.Flip(70)
+	if b.Test(70) {
+		t.Errorf("Expected bit 70 to be clear after second Flip")
+	}
+}
+
+func TestBitSet_NextSet(t *testing.T) {
+	b := From(1, 64, 130)
+
+	var got []uint
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		got = append(got, i)
+	}
+	if !reflect.DeepEqual(got, []uint{1, 64, 130}) {
+		t.Errorf("Expected [1 64 130], got %v", got)
+	}
+
+	if _, ok := b.NextSet(131); ok {
+		t.Errorf("Expected no set bit after 130")
+	}
+	if _, ok := New(0).NextSet(0); ok {
+		t.Errorf("Expected no set bit in an empty bit set")
+	}
+}
+
+func TestBitSet_NextClear(t *testing.T) {
+	b := New(0)
+	for i := uint(0); i < 70; i++ {
+		b.Set(i)
+	}
+	b.Clear(5)
+
+	if i, ok := b.NextClear(0); !ok || i != 5 {
+		t.Errorf("Expected next clear bit 5, got %d (%v)", i, ok)
+	}
+	if i, ok := b.NextClear(6); !ok || i != 70 {
+		t.Errorf("Expected next clear bit 70, got %d (%v)", i, ok)
+	}
+
+	// A fully set word falls through to the end of the bit set
+	full := New(64)
+	for i := uint(0); i < 64; i++ {
+		full.Set(i)
+	}
+	if i, ok := full.NextClear(0); !ok || i != 64 {
+		t.Errorf("Expected next clear bit 64, got %d (%v)", i, ok)
+	}
+	if i, ok := full.NextClear(500); !ok || i != 500 {
+		t.Errorf("Expected next clear bit 500, got %d (%v)", i, ok)
+	}
+}
+
+func TestBitSet_CountAndValues(t *testing.T) {
+	b := From(0, 63, 64, 127, 128)
+	if b.Count() != 5 {
+		t.Errorf("Expected count 5, got %d", b.Count())
+	}
+	if !reflect.DeepEqual(b.Values(), []uint{0, 63, 64, 127, 128}) {
+		t.Errorf("Unexpected values %v", b.Values())
+	}
+
+	b.ClearAll()
+	if !b.IsEmpty() || b.Count() != 0 {
+		t.Errorf("Expected bit set to be empty after ClearAll")
+	}
+	if b.Len() == 0 {
+		t.Errorf("Expected ClearAll to keep the allocated length")
+	}
+}
+
+func TestBitSet_BinaryOperations(t *testing.T) {
+	a := From(1, 2, 3, 100)
+	b := From(2, 3, 4)
+
+	if got := a.And(b).Values(); !reflect.DeepEqual(got, []uint{2, 3}) {
+		t.Errorf("And: expected [2 3], got %v", got)
+	}
+	if got := a.Or(b).Values(); !reflect.DeepEqual(got, []uint{1, 2, 3, 4, 100}) {
+		t.Errorf("Or: expected [1 2 3 4 100], got %v", got)
+	}
+	if got := a.Xor(b).Values(); !reflect.DeepEqual(got, []uint{1, 4, 100}) {
+		t.Errorf("Xor: expected [1 4 100], got %v", got)
+	}
+	if got := a.AndNot(b).Values(); !reflect.DeepEqual(got, []uint{1, 100}) {
+		t.Errorf("AndNot: expected [1 100], got %v", got)
+	}
+
+	// The allocating variants must not modify their operands
+	if !reflect.DeepEqual(a.Values(), []uint{1, 2, 3, 100}) {
+		t.Errorf("Expected a to be unchanged, got %v", a.Values())
+	}
+	if !reflect.DeepEqual(b.Values(), []uint{2, 3, 4}) {
+		t.Errorf("Expected b to be unchanged, got %v", b.Values())
+	}
+}
+
+func TestBitSet_InPlaceOperations(t *testing.T) {
+	a := From(1, 2, 3, 100)
+	a.InPlaceAnd(From(2, 3, 4))
+	if got := a.Values(); !reflect.DeepEqual(got, []uint{2, 3}) {
+		t.Errorf("InPlaceAnd: expected [2 3], got %v", got)
+	}
+
+	a.InPlaceOr(From(300))
+	if got := a.Values(); !reflect.DeepEqual(got, []uint{2, 3, 300}) {
+		t.Errorf("InPlaceOr: expected [2 3 300], got %v", got)
+	}
+
+	a.InPlaceXor(From(3, 5))
+	if got := a.Values(); !reflect.DeepEqual(got, []uint{2, 5, 300}) {
+		t.Errorf("InPlaceXor: expected [2 5 300], got %v", got)
+	}
+
+	a.InPlaceAndNot(From(2, 1000))
+	if got := a.Values(); !reflect.DeepEqual(got, []uint{5, 300}) {
+		t.Errorf("InPlaceAndNot: expected [5 300], got %v", got)
+	}
+}
+
+func TestBitSet_Equal(t *testing.T) {
+	a := From(1, 2)
+	b := New(1000)
+	b.Set(1)
+	b.Set(2)
+
+	if !a.Equal(b) || !b.Equal(a) {
+		t.Errorf("Expected bit sets with the same bits but different lengths to be equal")
+	}
+
+	b.Set(999)
+	if a.Equal(b) || b.Equal(a) {
+		t.Errorf("Expected bit sets with different bits to be different")
+	}
+}
+
+func TestBitSet_IsSuperset(t *testing.T) {
+	a := From(1, 2, 3, 200)
+	if !a.IsSuperset(From(1, 200)) {
+		t.Errorf("Expected a to be a superset of {1, 200}")
+	}
+	if !a.IsSuperset(New(0)) {
+		t.Errorf("Expected every bit set to be a superset of the empty set")
+	}
+	if a.IsSuperset(From(1, 500)) {
+		t.Errorf("Expected a not to be a superset of {1, 500}")
+	}
+}
+
+func TestBitSet_String(t *testing.T) {
+	b := From(3, 1)
+	expected := "BitSet elements: {1, 3}"
+	if b.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, b.String())
+	}
+}
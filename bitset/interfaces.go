@@ -0,0 +1,21 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package bitset
+
+// Set defines the behavior of a bit set.
+type Set interface {
+	Set(i uint)                    // Set sets bit i to 1, growing the set if needed.
+	Clear(i uint)                  // Clear sets bit i to 0.
+	Flip(i uint)                   // Flip toggles bit i.
+	Test(i uint) bool              // Test reports whether bit i is set.
+	NextSet(i uint) (uint, bool)   // NextSet returns the first set bit at or after i.
+	NextClear(i uint) (uint, bool) // NextClear returns the first clear bit at or after i.
+	Count() uint                   // Count returns the number of set bits.
+	Len() uint                     // Len returns the number of bits the set can hold without growing.
+	IsEmpty() bool                 // IsEmpty checks if no bit is set.
+	ClearAll()                     // ClearAll sets every bit to 0.
+	Values() []uint                // Values returns the indices of all set bits in ascending order.
+	String() string                // String returns a string representation of the bit set.
+}
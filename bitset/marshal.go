@@ -0,0 +1,57 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package bitset
+
+import (
+	"encoding/binary"
+	"encoding/json"
+)
+
+// MarshalBinary encodes the bit set as a big-endian word count followed by the words
+func (b *BitSet) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 8+8*len(b.words))
+	binary.BigEndian.PutUint64(data, uint64(len(b.words)))
+	for i, word := range b.words {
+		binary.BigEndian.PutUint64(data[8+8*i:], word)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes data produced by MarshalBinary, replacing the content of the bit set
+func (b *BitSet) UnmarshalBinary(data []byte) error {
+	if len(data) < 8 {
+		return ErrInvalidEncoding
+	}
+	n := binary.BigEndian.Uint64(data)
+	if uint64(len(data)-8)/8 != n || (len(data)-8)%8 != 0 {
+		return ErrInvalidEncoding
+	}
+
+	words := make([]uint64, n)
+	for i := range words {
+		words[i] = binary.BigEndian.Uint64(data[8+8*i:])
+	}
+	b.words = words
+	return nil
+}
+
+// MarshalJSON encodes the bit set as a JSON array of the indices of its set bits
+func (b *BitSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Values())
+}
+
+// UnmarshalJSON decodes a JSON array of indices, replacing the content of the bit set
+func (b *BitSet) UnmarshalJSON(data []byte) error {
+	var indices []uint
+	if err := json.Unmarshal(data, &indices); err != nil {
+		return err
+	}
+
+	b.words = nil
+	for _, i := range indices {
+		b.Set(i)
+	}
+	return nil
+}
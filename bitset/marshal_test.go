@@ -0,0 +1,57 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package bitset
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBitSet_MarshalBinary(t *testing.T) {
+	b := From(0, 65, 1000)
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	decoded := New(0)
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !decoded.Equal(b) {
+		t.Errorf("Expected %v after round trip, got %v", b, decoded)
+	}
+
+	// Truncated input must be rejected
+	if err := decoded.UnmarshalBinary(data[:len(data)-1]); err != ErrInvalidEncoding {
+		t.Errorf("Expected ErrInvalidEncoding, got %v", err)
+	}
+	if err := decoded.UnmarshalBinary(nil); err != ErrInvalidEncoding {
+		t.Errorf("Expected ErrInvalidEncoding, got %v", err)
+	}
+}
+
+func TestBitSet_MarshalJSON(t *testing.T) {
+	b := From(2, 70)
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != "[2,70]" {
+		t.Errorf("Expected [2,70], got %s", data)
+	}
+
+	decoded := From(5)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !decoded.Equal(b) {
+		t.Errorf("Expected %v after round trip, got %v", b, decoded)
+	}
+
+	if err := json.Unmarshal([]byte(`[-1]`), decoded); err == nil {
+		t.Errorf("Expected an error for a negative index")
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package bitset
+
+// BitSet defines a dense, growable set of non-negative integers backed by 64-bit words
+type BitSet struct {
+	words []uint64 // Underlying words, bit i is stored in words[i/64] at position i%64
+}
@@ -4,8 +4,22 @@
 
 package bloomfilters
 
+import "errors"
+
 // Default values for false positive rate and expected items count
 const (
 	DefaultFalsePositiveRate  = 0.01 // 1% false positive rate
 	DefaultExpectedItemsCount = 1000 // Default expected number of items in the dataset
 )
+
+// Binary encoding layout
+const (
+	// encodingVersion identifies the hash functions used to set the bits of an encoded bitmap.
+	// Version 1 hashes the seed before the item. Encodings without a version byte hashed it after the item,
+	// so their bits cannot be checked anymore and they are rejected.
+	encodingVersion = 1
+	headerSize      = 17 // Version byte, bitmap size and hash count
+)
+
+// ErrInvalidEncoding is returned by UnmarshalBinary when the data was not produced by MarshalBinary
+var ErrInvalidEncoding = errors.New("bloomfilters: invalid binary encoding")
@@ -130,9 +130,11 @@ func (bf *BloomFilters) hash(item string, seed uint64) uint64 {
 	//   as they require fewer bit operations.
 	// - Acceptable Trade-off: The use of 32-bit hashes strikes a balance between accuracy and performance,
 	//   without introducing significant risk of hash collisions.
+	// The seed goes first so that it changes the whole hash chain, appended last it only
+	// changes the final FNV round and the hash functions end up nearly identical.
 	h := fnv.New32a()
-	_, _ = h.Write([]byte(item))
 	_, _ = h.Write([]byte{byte(seed)})
+	_, _ = h.Write([]byte(item))
 	return uint64(h.Sum32())
 }
 
@@ -5,6 +5,7 @@
 package bloomfilters
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -110,3 +111,30 @@ func TestBloomFilterEdgeCases(t *testing.T) {
 		t.Errorf("Expected BloomFilter to be created with a valid expected item count")
 	}
 }
+
+// TestBloomFilterFalsePositiveRate tests that the measured false positive rate is close to the requested one
+func TestBloomFilterFalsePositiveRate(t *testing.T) {
+	for _, tc := range []struct {
+		rate  float64
+		items int
+	}{
+		{0.01, 1000},
+		{0.001, 10000},
+	} {
+		bf := New(tc.rate, tc.items)
+		for i := 0; i < tc.items; i++ {
+			bf.Add(fmt.Sprintf("item%d", i))
+		}
+
+		falsePositives := 0
+		for i := 0; i < 100000; i++ {
+			if bf.Contains(fmt.Sprintf("absent%d", i)) {
+				falsePositives++
+			}
+		}
+		// Leave room for the variance with twice the expected count
+		if expected := tc.rate * 100000; float64(falsePositives) > 2*expected {
+			t.Errorf("Expected about %v false positives in 100000 at rate %v, got %d", expected, tc.rate, falsePositives)
+		}
+	}
+}
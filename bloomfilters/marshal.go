@@ -0,0 +1,35 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package bloomfilters
+
+import "encoding/binary"
+
+// MarshalBinary encodes the Bloom Filter as a version byte, its bitmap size and hash count, followed by the bitmap
+func (bf *BloomFilters) MarshalBinary() ([]byte, error) {
+	data := make([]byte, headerSize, headerSize+len(bf.bitmap))
+	data[0] = encodingVersion
+	binary.BigEndian.PutUint64(data[1:9], bf.bitmapSize)
+	binary.BigEndian.PutUint64(data[9:17], bf.hashCount)
+	return append(data, bf.bitmap...), nil
+}
+
+// UnmarshalBinary decodes data produced by MarshalBinary, replacing the content of the Bloom Filter
+// Data written with another version of the hash functions is rejected with ErrInvalidEncoding.
+func (bf *BloomFilters) UnmarshalBinary(data []byte) error {
+	if len(data) < headerSize || data[0] != encodingVersion {
+		return ErrInvalidEncoding
+	}
+	bitmapSize := binary.BigEndian.Uint64(data[1:9])
+	hashCount := binary.BigEndian.Uint64(data[9:17])
+	bitmap := data[headerSize:]
+	if bitmapSize == 0 || hashCount == 0 || uint64(len(bitmap)) != (bitmapSize+7)/8 {
+		return ErrInvalidEncoding
+	}
+
+	bf.bitmap = append([]byte(nil), bitmap...)
+	bf.bitmapSize = bitmapSize
+	bf.hashCount = hashCount
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package bloomfilters
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestBloomFilterMarshalBinary tests that an encoded Bloom Filter answers like the original one
+func TestBloomFilterMarshalBinary(t *testing.T) {
+	bf := New(0.01, 100)
+	bf.Add("apple")
+	bf.Add("banana")
+
+	data, err := bf.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	decoded := &BloomFilters{}
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !decoded.Contains("apple") || !decoded.Contains("banana") {
+		t.Errorf("Expected decoded BloomFilter to contain the added items")
+	}
+	if decoded.HashCount() != bf.HashCount() || decoded.Size() != bf.Size() {
+		t.Errorf("Expected %v, got %v", bf, decoded)
+	}
+
+	// The decoded filter does not share its bitmap with the data
+	size := decoded.Size()
+	for i := headerSize; i < len(data); i++ {
+		data[i] = 0
+	}
+	if !decoded.Contains("apple") || !decoded.Contains("banana") || decoded.Size() != size {
+		t.Errorf("Expected decoded BloomFilter to own its bitmap")
+	}
+}
+
+// TestBloomFilterUnmarshalBinaryInvalid tests that malformed data is rejected
+func TestBloomFilterUnmarshalBinaryInvalid(t *testing.T) {
+	data, _ := New(0.01, 100).MarshalBinary()
+
+	for _, invalid := range [][]byte{nil, data[:10], data[:len(data)-1], append(data, 0)} {
+		if err := (&BloomFilters{}).UnmarshalBinary(invalid); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("Expected ErrInvalidEncoding for %d bytes, got %v", len(invalid), err)
+		}
+	}
+
+	// Encodings without a version byte used other hash functions
+	if err := (&BloomFilters{}).UnmarshalBinary(data[1:]); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected ErrInvalidEncoding for an unversioned encoding, got %v", err)
+	}
+	unknown := append([]byte(nil), data...)
+	unknown[0] = encodingVersion + 1
+	if err := (&BloomFilters{}).UnmarshalBinary(unknown); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected ErrInvalidEncoding for an unknown version, got %v", err)
+	}
+}
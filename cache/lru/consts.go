@@ -0,0 +1,8 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package lru
+
+// DefaultCapacity is the capacity used when an invalid one is given
+const DefaultCapacity = 128
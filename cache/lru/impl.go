@@ -0,0 +1,204 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// Reference: https://en.wikipedia.org/wiki/Cache_replacement_policies#LRU
+
+package lru
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethan-gao-code/go-ds/lists/doublylinkedlist"
+)
+
+// New creates a new LRU cache holding up to capacity entries
+// An invalid capacity falls back to DefaultCapacity. The cache is not safe for concurrent use, see NewSync.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	return NewWithOptions[K, V](int64(capacity), nil, nil)
+}
+
+// NewWithOptions creates a new LRU cache with custom options
+// capacity: maximum total cost of the entries (default DefaultCapacity)
+// cost: cost of an entry, computed once when it is stored, nil counts every entry as 1
+// onEvict: called with every entry evicted to make room, nil to ignore evictions
+// An invalid capacity falls back to the default, and negative costs count as 0.
+func NewWithOptions[K comparable, V any](capacity int64, cost func(key K, value V) int64, onEvict func(key K, value V)) *Cache[K, V] {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	return &Cache[K, V]{
+		list:     doublylinkedlist.NewGenericList[*entry[K, V]](),
+		items:    make(map[K]*doublylinkedlist.GenericNode[*entry[K, V]]),
+		capacity: capacity,
+		cost:     cost,
+		onEvict:  onEvict,
+	}
+}
+
+// Get returns the value of a key and marks it as the most recently used
+// The boolean reports whether the key was cached, it counts as a hit or a miss in the statistics.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	node, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.list.MoveToFront(node)
+	return node.Value().value, true
+}
+
+// Peek returns the value of a key without changing its recency or the statistics
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	node, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return node.Value().value, true
+}
+
+// Put sets the value of a key and marks it as the most recently used
+// The least recently used entries are evicted until the cache fits its capacity.
+// It returns false if the cost of the entry alone exceeds the capacity, the entry is then not stored
+// and any previous value of the key is removed, so that no stale value stays cached.
+func (c *Cache[K, V]) Put(key K, value V) bool {
+	cost := c.entryCost(key, value)
+	if node, ok := c.items[key]; ok {
+		c.delete(node)
+	}
+	if cost > c.capacity {
+		return false
+	}
+
+	c.items[key] = c.list.AddFirst(&entry[K, V]{key: key, value: value, cost: cost})
+	c.used += cost
+	c.evict()
+	return true
+}
+
+// Remove removes a key from the cache, without calling the eviction callback
+// It returns false if the key was not cached.
+func (c *Cache[K, V]) Remove(key K) bool {
+	node, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.delete(node)
+	return true
+}
+
+// Contains checks if a key is cached, without changing its recency or the statistics
+func (c *Cache[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Resize changes the capacity of the cache and returns the number of entries evicted to fit it
+// An invalid capacity falls back to DefaultCapacity.
+func (c *Cache[K, V]) Resize(capacity int64) int {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	c.capacity = capacity
+	return c.evict()
+}
+
+// Len returns the number of entries in the cache
+func (c *Cache[K, V]) Len() int {
+	return c.list.Size()
+}
+
+// Cost returns the total cost of the entries, equal to Len when no cost function is given
+func (c *Cache[K, V]) Cost() int64 {
+	return c.used
+}
+
+// Capacity returns the maximum total cost of the entries
+func (c *Cache[K, V]) Capacity() int64 {
+	return c.capacity
+}
+
+// Keys returns the keys from the most to the least recently used
+func (c *Cache[K, V]) Keys() []K {
+	keys := make([]K, 0, c.list.Size())
+	for node := c.list.Front(); node != nil; node = node.Next() {
+		keys = append(keys, node.Value().key)
+	}
+	return keys
+}
+
+// Clear removes all entries from the cache, without calling the eviction callback
+// The statistics are kept, see ResetStats.
+func (c *Cache[K, V]) Clear() {
+	c.list = doublylinkedlist.NewGenericList[*entry[K, V]]()
+	c.items = make(map[K]*doublylinkedlist.GenericNode[*entry[K, V]])
+	c.used = 0
+}
+
+// Stats returns the hit, miss and eviction counters of the cache
+func (c *Cache[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// ResetStats sets the counters of the cache back to zero
+func (c *Cache[K, V]) ResetStats() {
+	c.stats = Stats{}
+}
+
+// String returns a string representation of the cache, from the most to the least recently used
+func (c *Cache[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("LRU cache entries: [")
+	for node := c.list.Front(); node != nil; node = node.Next() {
+		if node != c.list.Front() {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v: %v", node.Value().key, node.Value().value))
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// HitRatio returns the share of Get calls that found their key, 0 if Get was never called
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// entryCost returns the cost of an entry, negative costs count as 0
+func (c *Cache[K, V]) entryCost(key K, value V) int64 {
+	if c.cost == nil {
+		return 1
+	}
+	return max(c.cost(key, value), 0)
+}
+
+// evict removes the least recently used entries until the cache fits its capacity
+// It returns the number of evicted entries.
+func (c *Cache[K, V]) evict() int {
+	evicted := 0
+	for c.used > c.capacity {
+		e := c.delete(c.list.Back())
+		c.stats.Evictions++
+		evicted++
+		if c.onEvict != nil {
+			c.onEvict(e.key, e.value)
+		}
+	}
+	return evicted
+}
+
+// delete removes the node of an entry from the list and the map and returns the entry
+func (c *Cache[K, V]) delete(node *doublylinkedlist.GenericNode[*entry[K, V]]) *entry[K, V] {
+	e := c.list.RemoveNode(node)
+	delete(c.items, e.key)
+	c.used -= e.cost
+	return e
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package lru
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Both caches implement LRUCache
+var (
+	_ LRUCache[string, int] = (*Cache[string, int])(nil)
+	_ LRUCache[string, int] = (*SyncCache[string, int])(nil)
+)
+
+func TestCache_GetPut(t *testing.T) {
+	c := New[string, int](3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+
+	// Reading a makes b the least recently used
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("Expected 1, got %v %v", v, ok)
+	}
+	c.Put("d", 4)
+	if c.Contains("b") {
+		t.Errorf("Expected b to be evicted")
+	}
+	if !reflect.DeepEqual(c.Keys(), []string{"d", "a", "c"}) {
+		t.Errorf("Unexpected keys %v", c.Keys())
+	}
+
+	// Updating a key refreshes it without growing the cache
+	c.Put("c", 30)
+	if c.Len() != 3 || c.Keys()[0] != "c" {
+		t.Errorf("Unexpected cache after update %v", c)
+	}
+	if v, _ := c.Get("c"); v != 30 {
+		t.Errorf("Expected 30, got %v", v)
+	}
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Expected b to be absent")
+	}
+	if c.String() != "LRU cache entries: [c: 30, d: 4, a: 1]" {
+		t.Errorf("Unexpected string %s", c.String())
+	}
+}
+
+func TestCache_PeekRemove(t *testing.T) {
+	c := New[int, string](2)
+	c.Put(1, "one")
+	c.Put(2, "two")
+
+	// Peek does not refresh the key, so 1 is still the next to go
+	if v, ok := c.Peek(1); !ok || v != "one" {
+		t.Errorf("Expected one, got %v %v", v, ok)
+	}
+	if _, ok := c.Peek(3); ok {
+		t.Errorf("Expected 3 to be absent")
+	}
+	c.Put(3, "three")
+	if c.Contains(1) || !c.Contains(2) {
+		t.Errorf("Expected 1 to be evicted, got %v", c)
+	}
+	if c.Stats() != (Stats{Evictions: 1}) {
+		t.Errorf("Expected Peek and Contains to leave the hit counters alone, got %+v", c.Stats())
+	}
+
+	if !c.Remove(2) || c.Remove(2) {
+		t.Errorf("Expected Remove to report whether the key was cached")
+	}
+	if c.Len() != 1 || c.Cost() != 1 {
+		t.Errorf("Unexpected size after Remove %v", c)
+	}
+
+	c.Clear()
+	if c.Len() != 0 || c.Cost() != 0 || len(c.Keys()) != 0 {
+		t.Errorf("Expected an empty cache, got %v", c)
+	}
+	c.Put(4, "four")
+	if v, _ := c.Get(4); v != "four" {
+		t.Errorf("Expected the cache to work after Clear")
+	}
+}
+
+func TestCache_Cost(t *testing.T) {
+	var evicted []string
+	c := NewWithOptions[string, string](10,
+		func(key, value string) int64 { return int64(len(value)) },
+		func(key, value string) { evicted = append(evicted, key) },
+	)
+
+	c.Put("a", "1234")
+	c.Put("b", "1234")
+	c.Put("c", "12")
+	if c.Cost() != 10 || c.Len() != 3 {
+		t.Fatalf("Unexpected cost %d", c.Cost())
+	}
+
+	// A large entry evicts as many entries as needed
+	c.Put("d", "12345678")
+	if !reflect.DeepEqual(evicted, []string{"a", "b"}) || c.Cost() != 10 {
+		t.Errorf("Unexpected evictions %v, cost %d", evicted, c.Cost())
+	}
+
+	// Growing a value is charged too
+	c.Put("c", "123")
+	if !reflect.DeepEqual(evicted, []string{"a", "b", "d"}) || c.Cost() != 3 {
+		t.Errorf("Unexpected evictions %v, cost %d", evicted, c.Cost())
+	}
+
+	// An entry larger than the capacity is rejected and drops the previous value
+	if c.Put("c", "12345678901") {
+		t.Errorf("Expected an entry over the capacity to be rejected")
+	}
+	if c.Contains("c") || c.Cost() != 0 {
+		t.Errorf("Expected the stale value to be removed, got %v", c)
+	}
+	if c.Stats().Evictions != 3 {
+		t.Errorf("Expected 3 evictions, got %d", c.Stats().Evictions)
+	}
+}
+
+func TestCache_Resize(t *testing.T) {
+	var evicted []int
+	c := NewWithOptions[int, int](5, nil, func(key, value int) { evicted = append(evicted, key) })
+	for i := 0; i < 5; i++ {
+		c.Put(i, i)
+	}
+
+	if n := c.Resize(2); n != 3 || c.Capacity() != 2 {
+		t.Errorf("Expected 3 evictions, got %d", n)
+	}
+	if !reflect.DeepEqual(evicted, []int{0, 1, 2}) || !reflect.DeepEqual(c.Keys(), []int{4, 3}) {
+		t.Errorf("Unexpected evictions %v, keys %v", evicted, c.Keys())
+	}
+	if n := c.Resize(10); n != 0 || c.Len() != 2 {
+		t.Errorf("Expected growing not to evict, got %d", n)
+	}
+	if c.Resize(0); c.Capacity() != DefaultCapacity {
+		t.Errorf("Expected an invalid capacity to fall back to the default, got %d", c.Capacity())
+	}
+	if New[int, int](-1).Capacity() != DefaultCapacity {
+		t.Errorf("Expected an invalid capacity to fall back to the default")
+	}
+}
+
+func TestCache_Stats(t *testing.T) {
+	c := New[string, int](1)
+	if c.Stats().HitRatio() != 0 {
+		t.Errorf("Expected a zero hit ratio without reads")
+	}
+	c.Put("a", 1)
+	c.Get("a")
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Put("b", 2)
+
+	expected := Stats{Hits: 3, Misses: 1, Evictions: 1}
+	if c.Stats() != expected || c.Stats().HitRatio() != 0.75 {
+		t.Errorf("Expected %+v, got %+v", expected, c.Stats())
+	}
+	c.ResetStats()
+	if c.Stats() != (Stats{}) {
+		t.Errorf("Expected the counters to be reset, got %+v", c.Stats())
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package lru
+
+// LRUCache defines the interface for a least recently used cache
+type LRUCache[K comparable, V any] interface {
+	Get(key K) (V, bool)       // Returns the value of a key and marks it as the most recently used
+	Peek(key K) (V, bool)      // Returns the value of a key without changing its recency or the statistics
+	Put(key K, value V) bool   // Sets the value of a key, evicting the least recently used entries if needed
+	Remove(key K) bool         // Removes a key, returns false if it was absent
+	Contains(key K) bool       // Checks if a key is cached, without changing its recency
+	Resize(capacity int64) int // Changes the capacity, returns the number of evicted entries
+
+	Len() int        // Returns the number of entries
+	Cost() int64     // Returns the total cost of the entries
+	Capacity() int64 // Returns the capacity
+	Keys() []K       // Returns the keys from the most to the least recently used
+	Clear()          // Removes all entries, without calling the eviction callback
+	Stats() Stats    // Returns the hit, miss and eviction counters
+	ResetStats()     // Sets the counters back to zero
+	String() string  // Returns a string representation of the cache
+}
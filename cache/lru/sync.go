@@ -0,0 +1,115 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package lru
+
+// NewSync creates a new goroutine-safe LRU cache holding up to capacity entries
+// An invalid capacity falls back to DefaultCapacity.
+func NewSync[K comparable, V any](capacity int) *SyncCache[K, V] {
+	return &SyncCache[K, V]{cache: New[K, V](capacity)}
+}
+
+// NewSyncWithOptions creates a new goroutine-safe LRU cache, the options are the ones of NewWithOptions
+// onEvict is called while the cache is locked, so it must not use the cache.
+func NewSyncWithOptions[K comparable, V any](capacity int64, cost func(key K, value V) int64, onEvict func(key K, value V)) *SyncCache[K, V] {
+	return &SyncCache[K, V]{cache: NewWithOptions(capacity, cost, onEvict)}
+}
+
+// Get returns the value of a key and marks it as the most recently used
+func (s *SyncCache[K, V]) Get(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Get(key)
+}
+
+// Peek returns the value of a key without changing its recency or the statistics
+func (s *SyncCache[K, V]) Peek(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Peek(key)
+}
+
+// Put sets the value of a key, evicting the least recently used entries if needed
+func (s *SyncCache[K, V]) Put(key K, value V) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Put(key, value)
+}
+
+// Remove removes a key from the cache
+func (s *SyncCache[K, V]) Remove(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Remove(key)
+}
+
+// Contains checks if a key is cached, without changing its recency or the statistics
+func (s *SyncCache[K, V]) Contains(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Contains(key)
+}
+
+// Resize changes the capacity of the cache and returns the number of evicted entries
+func (s *SyncCache[K, V]) Resize(capacity int64) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Resize(capacity)
+}
+
+// Len returns the number of entries in the cache
+func (s *SyncCache[K, V]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Len()
+}
+
+// Cost returns the total cost of the entries
+func (s *SyncCache[K, V]) Cost() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Cost()
+}
+
+// Capacity returns the maximum total cost of the entries
+func (s *SyncCache[K, V]) Capacity() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Capacity()
+}
+
+// Keys returns the keys from the most to the least recently used
+func (s *SyncCache[K, V]) Keys() []K {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Keys()
+}
+
+// Clear removes all entries from the cache
+func (s *SyncCache[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache.Clear()
+}
+
+// Stats returns the hit, miss and eviction counters of the cache
+func (s *SyncCache[K, V]) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Stats()
+}
+
+// ResetStats sets the counters of the cache back to zero
+func (s *SyncCache[K, V]) ResetStats() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache.ResetStats()
+}
+
+// String returns a string representation of the cache
+func (s *SyncCache[K, V]) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.String()
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package lru
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSyncCache_Concurrent(t *testing.T) {
+	c := NewSync[int, int](64)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				key := (g*1000 + i) % 100
+				if v, ok := c.Get(key); ok && v != key {
+					t.Errorf("Expected %d, got %d", key, v)
+					return
+				}
+				c.Put(key, key)
+				if i%10 == 0 {
+					c.Remove(key)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if c.Len() > 64 || c.Cost() != int64(c.Len()) || len(c.Keys()) != c.Len() {
+		t.Errorf("Unexpected cache size %d", c.Len())
+	}
+	stats := c.Stats()
+	if stats.Hits+stats.Misses != 8000 {
+		t.Errorf("Expected 8000 reads, got %+v", stats)
+	}
+}
+
+func TestSyncCache_Options(t *testing.T) {
+	evicted := 0
+	c := NewSyncWithOptions[string, []byte](8,
+		func(key string, value []byte) int64 { return int64(len(value)) },
+		func(key string, value []byte) { evicted++ },
+	)
+	c.Put("a", make([]byte, 4))
+	c.Put("b", make([]byte, 4))
+	c.Put("c", make([]byte, 4))
+	if evicted != 1 || c.Cost() != 8 || c.Contains("a") {
+		t.Errorf("Expected a to be evicted, got %v", c)
+	}
+	if v, ok := c.Peek("b"); !ok || len(v) != 4 {
+		t.Errorf("Expected b to be cached")
+	}
+	if c.Resize(4) != 1 || c.Capacity() != 4 || c.Keys()[0] != "c" {
+		t.Errorf("Expected Resize to evict b")
+	}
+	c.Clear()
+	c.ResetStats()
+	if c.Len() != 0 || c.Stats() != (Stats{}) || c.String() != "LRU cache entries: []" {
+		t.Errorf("Expected an empty cache, got %v", c)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package lru
+
+import (
+	"sync"
+
+	"github.com/ethan-gao-code/go-ds/lists/doublylinkedlist"
+)
+
+// Cache is a least recently used cache, it evicts the entries used the longest time ago once it is full
+// The capacity bounds the number of entries, or their total cost if a cost function is given.
+type Cache[K comparable, V any] struct {
+	list     *doublylinkedlist.GenericList[*entry[K, V]]       // Entries from the most to the least recently used
+	items    map[K]*doublylinkedlist.GenericNode[*entry[K, V]] // Node of every key in list
+	capacity int64                                             // Maximum total cost of the entries
+	used     int64                                             // Total cost of the entries
+	cost     func(key K, value V) int64                        // Cost of an entry, nil if every entry costs 1
+	onEvict  func(key K, value V)                              // Called for every evicted entry, may be nil
+	stats    Stats                                             // Hit and miss counters
+}
+
+// entry is a key/value pair stored in the cache
+type entry[K comparable, V any] struct {
+	key   K     // Key of the entry
+	value V     // Value of the entry
+	cost  int64 // Cost of the entry when it was stored
+}
+
+// Stats holds the counters of a cache
+type Stats struct {
+	Hits      uint64 // Number of Get calls that found their key
+	Misses    uint64 // Number of Get calls that did not find their key
+	Evictions uint64 // Number of entries evicted to make room
+}
+
+// SyncCache wraps a Cache to make it safe for concurrent use
+type SyncCache[K comparable, V any] struct {
+	mu    sync.Mutex   // Guards cache, Get updates the recency so reads lock too
+	cache *Cache[K, V] // Wrapped cache
+}
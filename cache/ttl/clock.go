@@ -0,0 +1,113 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package ttl
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// SystemClock is the Clock reading the time from the system, it is used when no clock is given
+var SystemClock Clock = systemClock{}
+
+// Now returns the current time of the system
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTimer returns a timer firing once d has elapsed
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{timer: time.NewTimer(d)}
+}
+
+// Chan returns the channel receiving the time when the timer fires
+func (t systemTimer) Chan() <-chan time.Time {
+	return t.timer.C
+}
+
+// Stop prevents the timer from firing, it returns false if the timer already fired or was stopped
+func (t systemTimer) Stop() bool {
+	return t.timer.Stop()
+}
+
+// NewFakeClock creates a new fake clock set to now
+func NewFakeClock(now time.Time) *FakeClock {
+	c := &FakeClock{now: now}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now returns the current time of the clock
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer returns a timer firing once the clock has been advanced by d
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := &fakeTimer{clock: c, deadline: c.now.Add(d), ch: make(chan time.Time, 1)}
+	if d <= 0 {
+		t.ch <- c.now
+		return t
+	}
+	c.waiters = append(c.waiters, t)
+	c.cond.Broadcast()
+	return t
+}
+
+// Advance moves the clock forward by d and fires the timers that are due
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	pending := c.waiters[:0]
+	for _, t := range c.waiters {
+		if t.deadline.After(c.now) {
+			pending = append(pending, t)
+		} else {
+			t.ch <- c.now
+		}
+	}
+	clear(c.waiters[len(pending):])
+	c.waiters = pending
+}
+
+// BlockUntil waits until n timers are waiting for the clock to advance
+// It lets a test make sure that a goroutine is waiting on the clock before advancing it.
+// Stopped timers are not counted.
+func (c *FakeClock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.waiters) < n {
+		c.cond.Wait()
+	}
+}
+
+// Chan returns the channel receiving the time of the clock when the timer fires
+func (t *fakeTimer) Chan() <-chan time.Time {
+	return t.ch
+}
+
+// Stop prevents the timer from firing and stops counting it in BlockUntil
+// It returns false if the timer already fired or was stopped.
+func (t *fakeTimer) Stop() bool {
+	c := t.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, w := range c.waiters {
+		if w == t {
+			c.waiters = slices.Delete(c.waiters, i, i+1)
+			return true
+		}
+	}
+	return false
+}
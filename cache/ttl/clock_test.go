@@ -0,0 +1,83 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package ttl
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	if !clock.Now().Equal(start) {
+		t.Errorf("Expected %v, got %v", start, clock.Now())
+	}
+
+	soon := clock.NewTimer(time.Second).Chan()
+	later := clock.NewTimer(time.Minute).Chan()
+	select {
+	case <-clock.NewTimer(0).Chan():
+	default:
+		t.Errorf("Expected a zero duration to fire at once")
+	}
+
+	clock.Advance(time.Second)
+	select {
+	case now := <-soon:
+		if !now.Equal(start.Add(time.Second)) {
+			t.Errorf("Unexpected firing time %v", now)
+		}
+	default:
+		t.Errorf("Expected the first channel to fire")
+	}
+	select {
+	case <-later:
+		t.Errorf("Expected the second channel to wait")
+	default:
+	}
+
+	clock.Advance(time.Hour)
+	if _, ok := <-later; !ok {
+		t.Errorf("Expected the second channel to fire")
+	}
+	clock.BlockUntil(0)
+}
+
+func TestFakeClock_Stop(t *testing.T) {
+	clock := NewFakeClock(time.Unix(0, 0))
+	stopped := clock.NewTimer(time.Second)
+	kept := clock.NewTimer(time.Second)
+
+	// A stopped timer never fires and is not counted by BlockUntil anymore
+	if !stopped.Stop() || stopped.Stop() {
+		t.Errorf("Expected only the first Stop to report a pending timer")
+	}
+	if n := pendingTimers(clock); n != 1 {
+		t.Errorf("Expected 1 pending timer, got %d", n)
+	}
+	clock.Advance(time.Second)
+	select {
+	case <-stopped.Chan():
+		t.Errorf("Expected the stopped timer not to fire")
+	default:
+	}
+	if _, ok := <-kept.Chan(); !ok || kept.Stop() {
+		t.Errorf("Expected the other timer to fire and Stop to report it")
+	}
+}
+
+func TestSystemClock(t *testing.T) {
+	before := time.Now()
+	if now := SystemClock.Now(); now.Before(before) {
+		t.Errorf("Expected the system time, got %v", now)
+	}
+	if _, ok := <-SystemClock.NewTimer(time.Millisecond).Chan(); !ok {
+		t.Errorf("Expected the channel to fire")
+	}
+	if timer := SystemClock.NewTimer(time.Hour); !timer.Stop() {
+		t.Errorf("Expected a pending timer to be stopped")
+	}
+}
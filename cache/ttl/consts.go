@@ -0,0 +1,20 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package ttl
+
+import "time"
+
+// Default values used when invalid ones are given
+const (
+	DefaultCapacity      = 128         // Maximum number of entries
+	DefaultSweepInterval = time.Minute // Time between two background sweeps
+)
+
+// Reasons given to the eviction callback
+const (
+	ReasonExpired  EvictionReason = iota // The entry outlived its TTL
+	ReasonCapacity                       // The entry was the least recently used one of a full cache
+	ReasonRemoved                        // The entry was removed with Remove
+)
@@ -0,0 +1,336 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package ttl
+
+import (
+	"cmp"
+	"math"
+	"time"
+
+	"github.com/ethan-gao-code/go-ds/lists/doublylinkedlist"
+	"github.com/ethan-gao-code/go-ds/lists/skiplist"
+)
+
+// New creates a new cache holding up to capacity entries, which expire ttl after they are written
+// An invalid capacity falls back to DefaultCapacity, and a ttl of 0 keeps the entries until they are evicted.
+func New[K comparable, V any](capacity int, ttl time.Duration) *Cache[K, V] {
+	return NewWithOptions[K, V](capacity, ttl, nil, nil)
+}
+
+// NewWithOptions creates a new cache with custom options
+// capacity: maximum number of entries, the least recently used ones are evicted (default DefaultCapacity)
+// ttl: time to live of the entries added with Put, 0 or less for entries that never expire
+// clock: source of the current time, nil for SystemClock
+// onEvict: called with every entry leaving the cache and the reason why, nil to ignore evictions.
+// It is called after the cache is unlocked, so it may use the cache.
+func NewWithOptions[K comparable, V any](capacity int, ttl time.Duration, clock Clock,
+	onEvict func(key K, value V, reason EvictionReason)) *Cache[K, V] {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	if clock == nil {
+		clock = SystemClock
+	}
+	return &Cache[K, V]{
+		list:      doublylinkedlist.NewGenericList[*entry[K, V]](),
+		items:     make(map[K]*doublylinkedlist.GenericNode[*entry[K, V]]),
+		deadlines: skiplist.NewGeneric[int64](compareEntries[K, V]),
+		capacity:  capacity,
+		ttl:       max(ttl, 0),
+		clock:     clock,
+		onEvict:   onEvict,
+	}
+}
+
+// compareEntries orders entries expiring at the same time by the order they were added in
+func compareEntries[K comparable, V any](a, b *entry[K, V]) int {
+	return cmp.Compare(a.seq, b.seq)
+}
+
+// Get returns the value of a key and marks it as the most recently used
+// An expired entry is removed and reported as absent.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	value, _, ok := c.GetWithTTL(key)
+	return value, ok
+}
+
+// GetWithTTL returns the value of a key and the time left before it expires, and marks it as the most recently used
+// The time left is 0 for an entry that never expires.
+func (c *Cache[K, V]) GetWithTTL(key K) (V, time.Duration, bool) {
+	c.mu.Lock()
+	defer c.unlock()
+
+	node, now := c.lookup(key)
+	if node == nil {
+		var zero V
+		return zero, 0, false
+	}
+	c.list.MoveToFront(node)
+	e := node.Value()
+	if e.deadline == 0 {
+		return e.value, 0, true
+	}
+	return e.value, time.Duration(e.deadline - now), true
+}
+
+// Peek returns the value of a key without changing its recency
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.unlock()
+
+	node, _ := c.lookup(key)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+	return node.Value().value, true
+}
+
+// Put sets the value of a key with the TTL of the cache and marks it as the most recently used
+func (c *Cache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.ttl)
+}
+
+// PutWithTTL sets the value of a key, which expires ttl from now, and marks it as the most recently used
+// A ttl of 0 or less keeps the entry until it is evicted. If the cache is full,
+// the expired entries are removed first, then the least recently used ones.
+// Replacing the value of a key is not reported to the eviction callback.
+func (c *Cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.unlock()
+
+	if node, ok := c.items[key]; ok {
+		c.unlink(node)
+	}
+	c.seq++
+	e := &entry[K, V]{key: key, value: value, ttl: max(ttl, 0), seq: c.seq}
+	now := c.clock.Now().UnixNano()
+	c.setDeadline(e, now)
+	c.items[key] = c.list.AddFirst(e)
+
+	if c.list.Size() > c.capacity {
+		c.deleteExpired(now)
+		for c.list.Size() > c.capacity {
+			c.delete(c.list.Back(), ReasonCapacity)
+		}
+	}
+}
+
+// Touch restarts the TTL of a key from now and marks it as the most recently used
+// It returns false if the key is absent or expired.
+func (c *Cache[K, V]) Touch(key K) bool {
+	c.mu.Lock()
+	defer c.unlock()
+
+	node, now := c.lookup(key)
+	if node == nil {
+		return false
+	}
+	e := node.Value()
+	if e.deadline != 0 {
+		c.deadlines.Remove(e.deadline, e)
+		c.setDeadline(e, now)
+	}
+	c.list.MoveToFront(node)
+	return true
+}
+
+// Remove removes a key from the cache, the eviction callback is called with ReasonRemoved
+// It returns false if the key is absent or expired.
+func (c *Cache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.unlock()
+
+	node, _ := c.lookup(key)
+	if node == nil {
+		return false
+	}
+	c.delete(node, ReasonRemoved)
+	return true
+}
+
+// Contains checks if a key is cached and not expired, without changing its recency
+func (c *Cache[K, V]) Contains(key K) bool {
+	c.mu.Lock()
+	defer c.unlock()
+
+	node, _ := c.lookup(key)
+	return node != nil
+}
+
+// Len returns the number of entries in the cache, including the expired ones that were not removed yet
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.Size()
+}
+
+// Keys returns the keys of the entries that are not expired, from the most to the least recently used
+func (c *Cache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now().UnixNano()
+	keys := make([]K, 0, c.list.Size())
+	for node := c.list.Front(); node != nil; node = node.Next() {
+		if !node.Value().expired(now) {
+			keys = append(keys, node.Value().key)
+		}
+	}
+	return keys
+}
+
+// Clear removes all entries from the cache, without calling the eviction callback
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.list = doublylinkedlist.NewGenericList[*entry[K, V]]()
+	c.items = make(map[K]*doublylinkedlist.GenericNode[*entry[K, V]])
+	c.deadlines = skiplist.NewGeneric[int64](compareEntries[K, V])
+}
+
+// DeleteExpired removes the expired entries and returns how many were removed
+// It only visits the expired entries, which are the first ones in deadline order.
+func (c *Cache[K, V]) DeleteExpired() int {
+	c.mu.Lock()
+	defer c.unlock()
+	return c.deleteExpired(c.clock.Now().UnixNano())
+}
+
+// StartSweeper starts a goroutine calling DeleteExpired every interval, until StopSweeper is called
+// An invalid interval falls back to DefaultSweepInterval. A sweeper already running is replaced,
+// and StartSweeper returns once it has stopped.
+func (c *Cache[K, V]) StartSweeper(interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultSweepInterval
+	}
+
+	c.mu.Lock()
+	stop, done := c.stop, c.done
+	c.stop, c.done = make(chan struct{}), make(chan struct{})
+	go c.sweep(interval, c.stop, c.done)
+	c.mu.Unlock()
+
+	// Wait for the replaced sweeper outside of the lock, which it needs to finish a sweep
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+// StopSweeper stops the goroutine started by StartSweeper and waits for it to return
+// Nothing happens if no sweeper runs.
+func (c *Cache[K, V]) StopSweeper() {
+	c.mu.Lock()
+	stop, done := c.stop, c.done
+	c.stop, c.done = nil, nil
+	c.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+// String returns the name of an eviction reason
+func (r EvictionReason) String() string {
+	switch r {
+	case ReasonExpired:
+		return "expired"
+	case ReasonCapacity:
+		return "capacity"
+	case ReasonRemoved:
+		return "removed"
+	}
+	return "unknown"
+}
+
+// sweep removes the expired entries every interval until stop is closed
+func (c *Cache[K, V]) sweep(interval time.Duration, stop, done chan struct{}) {
+	defer close(done)
+	for {
+		timer := c.clock.NewTimer(interval)
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case <-timer.Chan():
+			c.DeleteExpired()
+		}
+	}
+}
+
+// lookup returns the node of a key and the current time in Unix nanoseconds
+// An expired entry is removed, and nil is returned for it as for an absent key.
+func (c *Cache[K, V]) lookup(key K) (*doublylinkedlist.GenericNode[*entry[K, V]], int64) {
+	node, ok := c.items[key]
+	if !ok {
+		return nil, 0
+	}
+	now := c.clock.Now().UnixNano()
+	if node.Value().expired(now) {
+		c.delete(node, ReasonExpired)
+		return nil, now
+	}
+	return node, now
+}
+
+// setDeadline computes the deadline of an entry from now and tracks it if the entry expires
+func (c *Cache[K, V]) setDeadline(e *entry[K, V], now int64) {
+	if e.ttl == 0 {
+		e.deadline = 0
+		return
+	}
+	// Very long TTLs saturate instead of overflowing into the past
+	e.deadline = math.MaxInt64
+	if int64(e.ttl) < math.MaxInt64-now {
+		e.deadline = now + int64(e.ttl)
+	}
+	c.deadlines.Add(e.deadline, e)
+}
+
+// deleteExpired removes the entries whose deadline is not after now and returns how many were removed
+func (c *Cache[K, V]) deleteExpired(now int64) int {
+	n := 0
+	for first := c.deadlines.First(); first != nil && first.GetScore() <= now; first = c.deadlines.First() {
+		c.delete(c.items[first.GetMember().key], ReasonExpired)
+		n++
+	}
+	return n
+}
+
+// delete removes the node of an entry and queues its eviction for the callback
+func (c *Cache[K, V]) delete(node *doublylinkedlist.GenericNode[*entry[K, V]], reason EvictionReason) {
+	e := c.unlink(node)
+	if c.onEvict != nil {
+		c.evicted = append(c.evicted, eviction[K, V]{key: e.key, value: e.value, reason: reason})
+	}
+}
+
+// unlink removes the node of an entry from the list, the map and the deadlines and returns the entry
+func (c *Cache[K, V]) unlink(node *doublylinkedlist.GenericNode[*entry[K, V]]) *entry[K, V] {
+	e := c.list.RemoveNode(node)
+	delete(c.items, e.key)
+	if e.deadline != 0 {
+		c.deadlines.Remove(e.deadline, e)
+	}
+	return e
+}
+
+// unlock unlocks the cache, then reports the evictions queued while it was locked
+func (c *Cache[K, V]) unlock() {
+	evicted := c.evicted
+	c.evicted = nil
+	c.mu.Unlock()
+	for _, ev := range evicted {
+		c.onEvict(ev.key, ev.value, ev.reason)
+	}
+}
+
+// expired checks if an entry is expired at now
+func (e *entry[K, V]) expired(now int64) bool {
+	return e.deadline != 0 && e.deadline <= now
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package ttl
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+var _ TTLCache[string, int] = (*Cache[string, int])(nil)
+
+// evictionLog records the evictions reported to the callback of a cache
+type evictionLog struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+func (l *evictionLog) record(key string, value int, reason EvictionReason) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, key+":"+reason.String())
+}
+
+func (l *evictionLog) get() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.entries...)
+}
+
+// newTestCache creates a cache on a fake clock and records its evictions
+func newTestCache(capacity int, ttl time.Duration) (*Cache[string, int], *FakeClock, *evictionLog) {
+	clock := NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	log := &evictionLog{}
+	return NewWithOptions(capacity, ttl, clock, log.record), clock, log
+}
+
+func TestCache_Expiry(t *testing.T) {
+	c, clock, log := newTestCache(10, time.Minute)
+	c.Put("a", 1)
+	clock.Advance(30 * time.Second)
+	c.Put("b", 2)
+
+	if v, ttl, ok := c.GetWithTTL("a"); !ok || v != 1 || ttl != 30*time.Second {
+		t.Errorf("Expected 1 with 30s left, got %v %v %v", v, ttl, ok)
+	}
+
+	// a expires exactly at its deadline, b is still alive
+	clock.Advance(30 * time.Second)
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("Expected a to be expired")
+	}
+	if v, ok := c.Get("b"); !ok || v != 2 {
+		t.Errorf("Expected b to be alive, got %v %v", v, ok)
+	}
+	if c.Len() != 1 || !reflect.DeepEqual(log.get(), []string{"a:expired"}) {
+		t.Errorf("Expected the lookup to remove a, got %v %v", c.Len(), log.get())
+	}
+
+	// Expired entries are hidden from Keys before they are removed
+	clock.Advance(time.Minute)
+	if len(c.Keys()) != 0 || c.Len() != 1 {
+		t.Errorf("Expected b to be hidden but not removed yet, got %v", c.Keys())
+	}
+	if c.Contains("b") || c.Len() != 0 {
+		t.Errorf("Expected Contains to remove b")
+	}
+}
+
+func TestCache_PutWithTTL(t *testing.T) {
+	c, clock, _ := newTestCache(10, time.Minute)
+	c.PutWithTTL("short", 1, time.Second)
+	c.PutWithTTL("forever", 2, 0)
+	c.PutWithTTL("long", 3, time.Duration(1<<62))
+	c.Put("default", 4)
+
+	if _, ttl, _ := c.GetWithTTL("forever"); ttl != 0 {
+		t.Errorf("Expected no TTL, got %v", ttl)
+	}
+	clock.Advance(time.Second)
+	if c.Contains("short") {
+		t.Errorf("Expected short to be expired")
+	}
+	clock.Advance(24 * time.Hour)
+	if !reflect.DeepEqual(c.Keys(), []string{"forever", "long"}) {
+		t.Errorf("Unexpected keys %v", c.Keys())
+	}
+
+	// Replacing a value sets a new TTL
+	c.PutWithTTL("forever", 5, time.Second)
+	if v, ttl, _ := c.GetWithTTL("forever"); v != 5 || ttl != time.Second {
+		t.Errorf("Expected 5 with 1s left, got %v %v", v, ttl)
+	}
+	clock.Advance(time.Second)
+	if n := c.DeleteExpired(); n != 2 || c.Len() != 1 {
+		t.Errorf("Expected 2 expired entries, got %d", n)
+	}
+}
+
+func TestCache_Touch(t *testing.T) {
+	c, clock, _ := newTestCache(2, time.Minute)
+	c.Put("a", 1)
+	c.Put("b", 2)
+
+	clock.Advance(50 * time.Second)
+	if !c.Touch("a") {
+		t.Errorf("Expected Touch to find a")
+	}
+	if _, ttl, _ := c.GetWithTTL("a"); ttl != time.Minute {
+		t.Errorf("Expected Touch to restart the TTL, got %v", ttl)
+	}
+
+	clock.Advance(10 * time.Second)
+	if c.Touch("b") || c.Touch("missing") {
+		t.Errorf("Expected Touch to fail on an expired or absent key")
+	}
+	if n := c.DeleteExpired(); n != 0 || c.Len() != 1 {
+		t.Errorf("Expected b to be removed by Touch, got %d %d", n, c.Len())
+	}
+
+	// Touch marks the key as the most recently used
+	c.PutWithTTL("c", 3, 0)
+	c.Touch("a")
+	c.Put("d", 4)
+	if !reflect.DeepEqual(c.Keys(), []string{"d", "a"}) {
+		t.Errorf("Unexpected keys %v", c.Keys())
+	}
+}
+
+func TestCache_Capacity(t *testing.T) {
+	c, clock, log := newTestCache(3, 0)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.PutWithTTL("c", 3, time.Second)
+	c.Get("a")
+
+	// The expired entry makes room before any live entry is evicted
+	clock.Advance(time.Second)
+	c.Put("d", 4)
+	if !reflect.DeepEqual(log.get(), []string{"c:expired"}) {
+		t.Errorf("Unexpected evictions %v", log.get())
+	}
+
+	// Then the least recently used entry goes
+	c.Put("e", 5)
+	if !reflect.DeepEqual(c.Keys(), []string{"e", "d", "a"}) {
+		t.Errorf("Unexpected keys %v", c.Keys())
+	}
+
+	if v, ok := c.Peek("a"); !ok || v != 1 {
+		t.Errorf("Expected a to be cached")
+	}
+	// Peek does not refresh a, so it is evicted next
+	c.Put("f", 6)
+	if c.Remove("a") || !c.Remove("d") {
+		t.Errorf("Expected a to be evicted and d removed")
+	}
+	expected := []string{"c:expired", "b:capacity", "a:capacity", "d:removed"}
+	if !reflect.DeepEqual(log.get(), expected) {
+		t.Errorf("Expected evictions %v, got %v", expected, log.get())
+	}
+
+	c.Clear()
+	if c.Len() != 0 || c.DeleteExpired() != 0 || len(log.get()) != 4 {
+		t.Errorf("Expected Clear to empty the cache silently")
+	}
+	if New[string, int](0, 0).capacity != DefaultCapacity {
+		t.Errorf("Expected an invalid capacity to fall back to the default")
+	}
+}
+
+func TestCache_CallbackReentrant(t *testing.T) {
+	// The callback runs once the cache is unlocked, so it can use the cache
+	clock := NewFakeClock(time.Unix(1000, 0))
+	var c *Cache[string, int]
+	var seen []string
+	c = NewWithOptions(1, 0, clock, func(key string, value int, reason EvictionReason) {
+		if !c.Contains(key) {
+			seen = append(seen, key)
+		}
+		c.Put("from callback", value)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	if !reflect.DeepEqual(seen, []string{"a", "b"}) {
+		t.Errorf("Expected the evicted keys to be gone when reported, got %v", seen)
+	}
+	if v, ok := c.Get("from callback"); !ok || v != 2 {
+		t.Errorf("Expected the callback to store a value, got %v", c.Keys())
+	}
+}
+
+// pendingTimers returns the number of timers of clock that have neither fired nor been stopped
+func pendingTimers(clock *FakeClock) int {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	return len(clock.waiters)
+}
+
+func TestCache_Sweeper(t *testing.T) {
+	c, clock, log := newTestCache(10, time.Minute)
+	c.Put("a", 1)
+	c.PutWithTTL("b", 2, 3*time.Minute)
+
+	c.StartSweeper(time.Minute)
+	defer c.StopSweeper()
+
+	// Wait for the sweeper to wait on the clock, then let a sweep happen
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	// The sweeper waits again once the sweep is done
+	clock.BlockUntil(1)
+	if c.Len() != 1 || !reflect.DeepEqual(log.get(), []string{"a:expired"}) {
+		t.Errorf("Expected the sweeper to remove a, got %v", log.get())
+	}
+
+	// Restarting replaces the sweeper, the timer of the old one is stopped
+	c.StartSweeper(2 * time.Minute)
+	clock.BlockUntil(1)
+	if n := pendingTimers(clock); n != 1 {
+		t.Errorf("Expected only the new sweeper to wait on the clock, got %d timers", n)
+	}
+	clock.Advance(2 * time.Minute)
+	clock.BlockUntil(1)
+	if c.Len() != 0 {
+		t.Errorf("Expected the sweeper to remove b")
+	}
+
+	c.StopSweeper()
+	c.StopSweeper()
+	if n := pendingTimers(clock); n != 0 {
+		t.Errorf("Expected no timer left after StopSweeper, got %d", n)
+	}
+	c.Put("c", 3)
+	clock.Advance(time.Hour)
+	if c.Len() != 1 {
+		t.Errorf("Expected no sweep after StopSweeper")
+	}
+}
+
+func TestCache_Concurrent(t *testing.T) {
+	c, clock, _ := newTestCache(50, time.Second)
+	c.StartSweeper(time.Second)
+	defer c.StopSweeper()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				key := string(rune('a' + (g*500+i)%40))
+				c.Put(key, i)
+				c.Get(key)
+				c.Touch(key)
+				if i%50 == 0 {
+					clock.Advance(500 * time.Millisecond)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if c.Len() > 50 || len(c.Keys()) > c.Len() {
+		t.Errorf("Unexpected cache size %d", c.Len())
+	}
+}
+
+func TestEvictionReason_String(t *testing.T) {
+	reasons := map[EvictionReason]string{
+		ReasonExpired:      "expired",
+		ReasonCapacity:     "capacity",
+		ReasonRemoved:      "removed",
+		EvictionReason(42): "unknown",
+	}
+	for reason, expected := range reasons {
+		if reason.String() != expected {
+			t.Errorf("Expected %s, got %s", expected, reason)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package ttl
+
+import "time"
+
+// Clock defines the source of time of a cache, SystemClock in production and a FakeClock in tests
+type Clock interface {
+	Now() time.Time                 // Returns the current time
+	NewTimer(d time.Duration) Timer // Returns a timer firing once d has elapsed
+}
+
+// Timer defines a single event created by a Clock, which can be stopped before it fires
+type Timer interface {
+	Chan() <-chan time.Time // Returns the channel receiving the time when the timer fires
+	Stop() bool             // Prevents the timer from firing, returns false if it already fired or was stopped
+}
+
+// TTLCache defines the interface for an LRU cache with expiring entries
+type TTLCache[K comparable, V any] interface {
+	Get(key K) (V, bool)                          // Returns the value of a key and marks it as the most recently used
+	GetWithTTL(key K) (V, time.Duration, bool)    // Like Get, also returns the time left before the entry expires
+	Peek(key K) (V, bool)                         // Returns the value of a key without changing its recency
+	Put(key K, value V)                           // Sets the value of a key with the default TTL
+	PutWithTTL(key K, value V, ttl time.Duration) // Sets the value of a key with a custom TTL
+	Touch(key K) bool                             // Restarts the TTL of a key and marks it as the most recently used
+	Remove(key K) bool                            // Removes a key, returns false if it was absent
+	Contains(key K) bool                          // Checks if a key is cached and not expired
+
+	Len() int           // Returns the number of entries, including expired ones not removed yet
+	Keys() []K          // Returns the live keys from the most to the least recently used
+	Clear()             // Removes all entries, without calling the eviction callback
+	DeleteExpired() int // Removes the expired entries, returns how many were removed
+
+	StartSweeper(interval time.Duration) // Starts removing the expired entries in the background
+	StopSweeper()                        // Stops the background sweeper
+}
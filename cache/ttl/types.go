@@ -0,0 +1,73 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package ttl
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethan-gao-code/go-ds/lists/doublylinkedlist"
+	"github.com/ethan-gao-code/go-ds/lists/skiplist"
+)
+
+// EvictionReason tells why an entry left the cache
+type EvictionReason int
+
+// Cache is a goroutine-safe LRU cache whose entries expire after their TTL
+// Expired entries are removed when they are looked up, when room is needed, by DeleteExpired
+// and by the background sweeper started with StartSweeper.
+type Cache[K comparable, V any] struct {
+	mu        sync.Mutex                                        // Guards all the fields below
+	list      *doublylinkedlist.GenericList[*entry[K, V]]       // Entries from the most to the least recently used
+	items     map[K]*doublylinkedlist.GenericNode[*entry[K, V]] // Node of every key in list
+	deadlines *skiplist.Generic[int64, *entry[K, V]]            // Entries that expire, ordered by deadline
+	capacity  int                                               // Maximum number of entries
+	ttl       time.Duration                                     // TTL of the entries added with Put, 0 for none
+	clock     Clock                                             // Source of the current time
+	onEvict   func(key K, value V, reason EvictionReason)       // Called for every evicted entry, may be nil
+	evicted   []eviction[K, V]                                  // Evictions to report once the cache is unlocked
+	seq       uint64                                            // Sequence number of the last added entry
+	stop      chan struct{}                                     // Closed to stop the sweeper, nil if none runs
+	done      chan struct{}                                     // Closed when the sweeper has stopped
+}
+
+// entry is a key/value pair stored in the cache
+type entry[K comparable, V any] struct {
+	key      K             // Key of the entry
+	value    V             // Value of the entry
+	ttl      time.Duration // Time to live of the entry, 0 if it never expires
+	deadline int64         // Expiry time in Unix nanoseconds, 0 if it never expires
+	seq      uint64        // Orders entries expiring at the same time in the deadlines skip list
+}
+
+// eviction is an evicted entry waiting to be reported to the callback
+type eviction[K comparable, V any] struct {
+	key    K              // Key of the evicted entry
+	value  V              // Value of the evicted entry
+	reason EvictionReason // Why the entry was evicted
+}
+
+// systemClock reads the time from the system
+type systemClock struct{}
+
+// systemTimer is a Timer of the system clock
+type systemTimer struct {
+	timer *time.Timer // Underlying timer of the time package
+}
+
+// FakeClock is a Clock that only moves when told to, so that expiry can be tested without sleeping
+type FakeClock struct {
+	mu      sync.Mutex   // Guards now and waiters
+	cond    *sync.Cond   // Signaled when a waiter is added, used by BlockUntil
+	now     time.Time    // Current time of the clock
+	waiters []*fakeTimer // Timers that have neither fired nor been stopped yet
+}
+
+// fakeTimer is a Timer of a FakeClock with the time it fires at
+type fakeTimer struct {
+	clock    *FakeClock     // Clock that fires the timer
+	deadline time.Time      // Time the channel receives at
+	ch       chan time.Time // Channel returned by Chan
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// zsetd is a small in-process stand-in for Redis sorted sets, backed by skiplist.SortedSet
+// It speaks RESP2 over TCP and implements PING, DEL, FLUSHALL, ZADD, ZREM, ZSCORE, ZRANK,
+// ZRANGE (with BYSCORE, BYLEX, REV, LIMIT and WITHSCORES), ZCARD, ZINCRBY and ZPOPMIN.
+//
+// Usage:
+//
+//	zsetd [-addr 127.0.0.1:6380]
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:6380", "address to listen on")
+	flag.Parse()
+
+	l, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("zsetd: listening on %s", l.Addr())
+	log.Fatal(newServer().serve(l))
+}
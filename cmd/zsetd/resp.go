@@ -0,0 +1,115 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// Reference: https://redis.io/docs/latest/develop/reference/protocol-spec/
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// maxBulkLength bounds the size of a single argument, like proto-max-bulk-len in Redis
+const maxBulkLength = 512 * 1024 * 1024
+
+// errProtocol is returned when a client sends something that is not valid RESP
+var errProtocol = errors.New("protocol error")
+
+// respReader reads commands sent by clients
+type respReader struct {
+	r *bufio.Reader
+}
+
+// respWriter writes RESP2 replies to clients
+type respWriter struct {
+	w *bufio.Writer
+}
+
+// readCommand reads a command as an array of bulk strings, or as an inline command
+// An empty inline command returns no arguments and no error.
+func (rr *respReader) readCommand() ([]string, error) {
+	line, err := rr.readLine()
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		// Inline commands are plain space-separated words, as typed in telnet
+		return strings.Fields(line), nil
+	}
+
+	count, err := strconv.Atoi(line[1:])
+	if err != nil || count > 1024*1024 {
+		return nil, fmt.Errorf("%w: invalid multibulk length", errProtocol)
+	}
+
+	args := make([]string, 0, max(count, 0))
+	for i := 0; i < count; i++ {
+		line, err := rr.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(line, "$") {
+			return nil, fmt.Errorf("%w: expected '$', got '%.1s'", errProtocol, line)
+		}
+		length, err := strconv.Atoi(line[1:])
+		if err != nil || length < 0 || length > maxBulkLength {
+			return nil, fmt.Errorf("%w: invalid bulk length", errProtocol)
+		}
+
+		// Read the bulk string and its trailing CRLF
+		buf := make([]byte, length+2)
+		if _, err := io.ReadFull(rr.r, buf); err != nil {
+			return nil, err
+		}
+		if buf[length] != '\r' || buf[length+1] != '\n' {
+			return nil, fmt.Errorf("%w: bulk string not terminated by CRLF", errProtocol)
+		}
+		args = append(args, string(buf[:length]))
+	}
+	return args, nil
+}
+
+// readLine reads a line terminated by CRLF, or by a single LF for inline commands
+func (rr *respReader) readLine() (string, error) {
+	line, err := rr.r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"), nil
+}
+
+// simpleString writes a status reply, like +OK
+func (rw *respWriter) simpleString(s string) {
+	rw.w.WriteString("+" + s + "\r\n")
+}
+
+// error writes an error reply, msg starts with an error code such as ERR
+func (rw *respWriter) error(msg string) {
+	rw.w.WriteString("-" + msg + "\r\n")
+}
+
+// integer writes an integer reply
+func (rw *respWriter) integer(n int) {
+	rw.w.WriteString(":" + strconv.Itoa(n) + "\r\n")
+}
+
+// bulk writes a bulk string reply
+func (rw *respWriter) bulk(s string) {
+	rw.w.WriteString("$" + strconv.Itoa(len(s)) + "\r\n" + s + "\r\n")
+}
+
+// null writes the null bulk string reply
+func (rw *respWriter) null() {
+	rw.w.WriteString("$-1\r\n")
+}
+
+// arrayHeader writes the header of an array reply of n elements, which must follow
+func (rw *respWriter) arrayHeader(n int) {
+	rw.w.WriteString("*" + strconv.Itoa(n) + "\r\n")
+}
@@ -0,0 +1,478 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"log"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethan-gao-code/go-ds/lists/skiplist"
+)
+
+// Error replies, worded like the ones of Redis so that clients behave the same
+const (
+	errSyntax        = "ERR syntax error"
+	errNotFloat      = "ERR value is not a valid float"
+	errMinMaxFloat   = "ERR min or max is not a float"
+	errNotInteger    = "ERR value is not an integer or out of range"
+	errNaN           = "ERR resulting score is not a number (NaN)"
+	errLexRange      = "ERR min or max not valid string range item"
+	errNXAndXX       = "ERR XX and NX options at the same time are not compatible"
+	errGTLTNX        = "ERR GT, LT, and/or NX options at the same time are not compatible"
+	errLimitNoByType = "ERR syntax error, LIMIT is only supported in combination with either BYSCORE or BYLEX"
+)
+
+// command describes a command the server understands
+type command struct {
+	// arity is the exact number of arguments including the command name, or minus the minimum number
+	arity int
+	// handler executes the command with the server lock held and writes its reply
+	handler func(s *server, rw *respWriter, args []string)
+}
+
+// commands maps lower-case command names to their implementation
+var commands = map[string]command{
+	"ping":     {-1, (*server).ping},
+	"del":      {-2, (*server).del},
+	"flushall": {-1, (*server).flushAll},
+	"zadd":     {-4, (*server).zadd},
+	"zrem":     {-3, (*server).zrem},
+	"zscore":   {3, (*server).zscore},
+	"zrank":    {3, (*server).zrank},
+	"zrange":   {-4, (*server).zrange},
+	"zcard":    {2, (*server).zcard},
+	"zincrby":  {4, (*server).zincrby},
+	"zpopmin":  {-2, (*server).zpopmin},
+}
+
+// server holds the sorted sets, every key is a sorted set
+type server struct {
+	mu   sync.Mutex                     // Serializes commands, like the single thread of Redis
+	sets map[string]*skiplist.SortedSet // Sorted sets by key, empty sets are deleted
+}
+
+// newServer creates a server with no keys
+func newServer() *server {
+	return &server{sets: make(map[string]*skiplist.SortedSet)}
+}
+
+// serve accepts connections on l and serves each of them in its own goroutine
+// It returns when l is closed.
+func (s *server) serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go s.handle(conn)
+	}
+}
+
+// handle reads commands from conn and writes their replies until the client disconnects
+func (s *server) handle(conn net.Conn) {
+	defer conn.Close()
+	rr := &respReader{r: bufio.NewReader(conn)}
+	rw := &respWriter{w: bufio.NewWriter(conn)}
+
+	for {
+		args, err := rr.readCommand()
+		if err != nil {
+			if errors.Is(err, errProtocol) {
+				rw.error("ERR Protocol error: " + strings.TrimPrefix(err.Error(), errProtocol.Error()+": "))
+				rw.w.Flush()
+			} else if !errors.Is(err, io.EOF) {
+				log.Printf("zsetd: %s: %v", conn.RemoteAddr(), err)
+			}
+			return
+		}
+		if len(args) > 0 {
+			s.execute(rw, args)
+		}
+
+		// Flush once the pipelined commands already received are answered
+		if rr.r.Buffered() == 0 {
+			if err := rw.w.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// execute looks up the command, checks its arity and runs it
+func (s *server) execute(rw *respWriter, args []string) {
+	name := strings.ToLower(args[0])
+	cmd, found := commands[name]
+	if !found {
+		rw.error("ERR unknown command '" + args[0] + "'")
+		return
+	}
+	if (cmd.arity > 0 && len(args) != cmd.arity) || (cmd.arity < 0 && len(args) < -cmd.arity) {
+		rw.error("ERR wrong number of arguments for '" + name + "' command")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cmd.handler(s, rw, args)
+}
+
+// ping implements PING [message]
+func (s *server) ping(rw *respWriter, args []string) {
+	switch len(args) {
+	case 1:
+		rw.simpleString("PONG")
+	case 2:
+		rw.bulk(args[1])
+	default:
+		rw.error("ERR wrong number of arguments for 'ping' command")
+	}
+}
+
+// del implements DEL key [key ...]
+func (s *server) del(rw *respWriter, args []string) {
+	deleted := 0
+	for _, key := range args[1:] {
+		if _, found := s.sets[key]; found {
+			delete(s.sets, key)
+			deleted++
+		}
+	}
+	rw.integer(deleted)
+}
+
+// flushAll implements FLUSHALL, options such as ASYNC are accepted and ignored
+func (s *server) flushAll(rw *respWriter, _ []string) {
+	s.sets = make(map[string]*skiplist.SortedSet)
+	rw.simpleString("OK")
+}
+
+// zadd implements ZADD key [NX|XX] [GT|LT] [CH] score member [score member ...]
+func (s *server) zadd(rw *respWriter, args []string) {
+	var flags skiplist.ZAddFlag
+	i := 2
+options:
+	for ; i < len(args); i++ {
+		switch strings.ToUpper(args[i]) {
+		case "NX":
+			flags |= skiplist.ZAddNX
+		case "XX":
+			flags |= skiplist.ZAddXX
+		case "GT":
+			flags |= skiplist.ZAddGT
+		case "LT":
+			flags |= skiplist.ZAddLT
+		case "CH":
+			flags |= skiplist.ZAddCH
+		default:
+			break options
+		}
+	}
+
+	rest := args[i:]
+	if len(rest) == 0 || len(rest)%2 != 0 {
+		rw.error(errSyntax)
+		return
+	}
+	if flags&skiplist.ZAddNX != 0 && flags&skiplist.ZAddXX != 0 {
+		rw.error(errNXAndXX)
+		return
+	}
+
+	elements := make([]skiplist.Element, 0, len(rest)/2)
+	for j := 0; j < len(rest); j += 2 {
+		score, ok := parseScore(rest[j])
+		if !ok {
+			rw.error(errNotFloat)
+			return
+		}
+		elements = append(elements, skiplist.Element{Score: score, Member: rest[j+1]})
+	}
+
+	z := s.getOrCreate(args[1])
+	n, err := z.ZAdd(flags, elements...)
+	s.dropIfEmpty(args[1])
+	if err != nil {
+		rw.error(errGTLTNX)
+		return
+	}
+	rw.integer(n)
+}
+
+// zrem implements ZREM key member [member ...]
+func (s *server) zrem(rw *respWriter, args []string) {
+	z, found := s.sets[args[1]]
+	if !found {
+		rw.integer(0)
+		return
+	}
+
+	members := make([]interface{}, 0, len(args)-2)
+	for _, member := range args[2:] {
+		members = append(members, member)
+	}
+	rw.integer(z.ZRem(members...))
+	s.dropIfEmpty(args[1])
+}
+
+// zscore implements ZSCORE key member
+func (s *server) zscore(rw *respWriter, args []string) {
+	z, found := s.sets[args[1]]
+	if !found {
+		rw.null()
+		return
+	}
+	score, found := z.ZScore(args[2])
+	if !found {
+		rw.null()
+		return
+	}
+	rw.bulk(formatScore(score))
+}
+
+// zrank implements ZRANK key member
+func (s *server) zrank(rw *respWriter, args []string) {
+	z, found := s.sets[args[1]]
+	if !found {
+		rw.null()
+		return
+	}
+	rank := z.ZRank(args[2])
+	if rank < 0 {
+		rw.null()
+		return
+	}
+	rw.integer(rank)
+}
+
+// zcard implements ZCARD key
+func (s *server) zcard(rw *respWriter, args []string) {
+	z, found := s.sets[args[1]]
+	if !found {
+		rw.integer(0)
+		return
+	}
+	rw.integer(z.ZCard())
+}
+
+// zincrby implements ZINCRBY key increment member
+func (s *server) zincrby(rw *respWriter, args []string) {
+	increment, ok := parseScore(args[2])
+	if !ok {
+		rw.error(errNotFloat)
+		return
+	}
+
+	z := s.getOrCreate(args[1])
+	score, err := z.ZIncrBy(increment, args[3])
+	s.dropIfEmpty(args[1])
+	if err != nil {
+		rw.error(errNaN)
+		return
+	}
+	rw.bulk(formatScore(score))
+}
+
+// zpopmin implements ZPOPMIN key [count]
+func (s *server) zpopmin(rw *respWriter, args []string) {
+	if len(args) > 3 {
+		rw.error(errSyntax)
+		return
+	}
+	count := 1
+	if len(args) == 3 {
+		n, err := strconv.Atoi(args[2])
+		if err != nil || n < 0 {
+			rw.error("ERR value is out of range, must be positive")
+			return
+		}
+		count = n
+	}
+
+	var elements []skiplist.Element
+	if z, found := s.sets[args[1]]; found {
+		elements = z.ZPopMin(count)
+		s.dropIfEmpty(args[1])
+	}
+	writeElements(rw, elements, true)
+}
+
+// zrange implements ZRANGE key start stop [BYSCORE|BYLEX] [REV] [LIMIT offset count] [WITHSCORES]
+func (s *server) zrange(rw *respWriter, args []string) {
+	var byScore, byLex, rev, withScores, limited bool
+	offset, limit := 0, -1
+	for i := 4; i < len(args); i++ {
+		switch strings.ToUpper(args[i]) {
+		case "BYSCORE":
+			byScore = true
+		case "BYLEX":
+			byLex = true
+		case "REV":
+			rev = true
+		case "WITHSCORES":
+			withScores = true
+		case "LIMIT":
+			if i+2 >= len(args) {
+				rw.error(errSyntax)
+				return
+			}
+			var err1, err2 error
+			offset, err1 = strconv.Atoi(args[i+1])
+			limit, err2 = strconv.Atoi(args[i+2])
+			if err1 != nil || err2 != nil {
+				rw.error(errNotInteger)
+				return
+			}
+			limited = true
+			i += 2
+		default:
+			rw.error(errSyntax)
+			return
+		}
+	}
+	if byScore && byLex {
+		rw.error(errSyntax)
+		return
+	}
+	if limited && !byScore && !byLex {
+		rw.error(errLimitNoByType)
+		return
+	}
+	if withScores && byLex {
+		rw.error("ERR syntax error, WITHSCORES not supported in combination with BYLEX")
+		return
+	}
+
+	// With REV, the range is given from the highest to the lowest bound
+	lower, upper := args[2], args[3]
+	if rev && (byScore || byLex) {
+		lower, upper = upper, lower
+	}
+
+	z, found := s.sets[args[1]]
+	if !found {
+		z = skiplist.NewSortedSet()
+	}
+
+	var elements []skiplist.Element
+	switch {
+	case byScore:
+		r, ok := parseScoreRange(lower, upper)
+		if !ok {
+			rw.error(errMinMaxFloat)
+			return
+		}
+		if rev {
+			elements = z.ZRevRangeByScore(r, offset, limit)
+		} else {
+			elements = z.ZRangeByScore(r, offset, limit)
+		}
+	case byLex:
+		r, err := skiplist.ParseLexRange(lower, upper)
+		if err != nil {
+			rw.error(errLexRange)
+			return
+		}
+		if rev {
+			elements = z.ZRevRangeByLex(r, offset, limit)
+		} else {
+			elements = z.ZRangeByLex(r, offset, limit)
+		}
+	default:
+		start, err1 := strconv.Atoi(lower)
+		stop, err2 := strconv.Atoi(upper)
+		if err1 != nil || err2 != nil {
+			rw.error(errNotInteger)
+			return
+		}
+		if rev {
+			elements = z.ZRevRangeByRank(start, stop)
+		} else {
+			elements = z.ZRangeByRank(start, stop)
+		}
+	}
+	writeElements(rw, elements, withScores)
+}
+
+// getOrCreate returns the sorted set at key, creating an empty one if needed
+func (s *server) getOrCreate(key string) *skiplist.SortedSet {
+	z, found := s.sets[key]
+	if !found {
+		z = skiplist.NewSortedSet()
+		s.sets[key] = z
+	}
+	return z
+}
+
+// dropIfEmpty deletes the key if its sorted set is empty, Redis never keeps empty sets
+func (s *server) dropIfEmpty(key string) {
+	if z, found := s.sets[key]; found && z.ZCard() == 0 {
+		delete(s.sets, key)
+	}
+}
+
+// writeElements writes the members as an array, each followed by its score if withScores is set
+func writeElements(rw *respWriter, elements []skiplist.Element, withScores bool) {
+	if withScores {
+		rw.arrayHeader(2 * len(elements))
+	} else {
+		rw.arrayHeader(len(elements))
+	}
+	for _, e := range elements {
+		rw.bulk(e.Member.(string))
+		if withScores {
+			rw.bulk(formatScore(e.Score))
+		}
+	}
+}
+
+// parseScore parses a score, accepting inf, +inf and -inf like Redis but rejecting NaN
+func parseScore(s string) (float64, bool) {
+	score, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(score) {
+		return 0, false
+	}
+	return score, true
+}
+
+// parseScoreRange parses score bounds, a leading '(' makes a bound exclusive
+func parseScoreRange(min, max string) (skiplist.ScoreRange, bool) {
+	var r skiplist.ScoreRange
+	var ok bool
+	if r.MinExclusive = strings.HasPrefix(min, "("); r.MinExclusive {
+		min = min[1:]
+	}
+	if r.MaxExclusive = strings.HasPrefix(max, "("); r.MaxExclusive {
+		max = max[1:]
+	}
+	if r.Min, ok = parseScore(min); !ok {
+		return r, false
+	}
+	if r.Max, ok = parseScore(max); !ok {
+		return r, false
+	}
+	return r, true
+}
+
+// formatScore formats a score the way Redis does, with the shortest exact representation
+func formatScore(score float64) string {
+	switch {
+	case math.IsInf(score, 1):
+		return "inf"
+	case math.IsInf(score, -1):
+		return "-inf"
+	default:
+		return strconv.FormatFloat(score, 'g', -1, 64)
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// respError is an error reply received by the test client
+type respError string
+
+func (e respError) Error() string {
+	return string(e)
+}
+
+// client is a minimal RESP2 client, replies are decoded into
+// string (simple and bulk strings), int64, nil, []interface{} and respError values.
+type client struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// startServer serves on a random local port until the test ends and returns a connected client
+func startServer(t *testing.T) *client {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	s := newServer()
+	go s.serve(l)
+	t.Cleanup(func() { l.Close() })
+	return dial(t, l.Addr().String())
+}
+
+// dial connects a new client to addr
+func dial(t *testing.T, addr string) *client {
+	t.Helper()
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return &client{conn: conn, r: bufio.NewReader(conn)}
+}
+
+// send writes a command as an array of bulk strings
+func (c *client) send(args ...string) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	_, err := io.WriteString(c.conn, b.String())
+	return err
+}
+
+// do sends a command and reads its reply
+func (c *client) do(t *testing.T, args ...string) interface{} {
+	t.Helper()
+	if err := c.send(args...); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	reply, err := c.read()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	return reply
+}
+
+// read reads a single reply
+func (c *client) read() (interface{}, error) {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, fmt.Errorf("empty reply line")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return respError(line[1:]), nil
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		length, _ := strconv.Atoi(line[1:])
+		if length < 0 {
+			return nil, nil
+		}
+		buf := make([]byte, length+2)
+		if _, err := io.ReadFull(c.r, buf); err != nil {
+			return nil, err
+		}
+		return string(buf[:length]), nil
+	case '*':
+		count, _ := strconv.Atoi(line[1:])
+		items := make([]interface{}, 0, count)
+		for i := 0; i < count; i++ {
+			item, err := c.read()
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("unexpected reply %q", line)
+}
+
+// bulks builds the expected decoding of an array of bulk strings
+func bulks(values ...string) []interface{} {
+	items := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		items = append(items, v)
+	}
+	return items
+}
+
+func TestServer_Basics(t *testing.T) {
+	c := startServer(t)
+
+	tests := []struct {
+		args     []string
+		expected interface{}
+	}{
+		{[]string{"PING"}, "PONG"},
+		{[]string{"ping", "hello"}, "hello"},
+		{[]string{"ZADD", "z", "1", "a", "2", "b", "3", "c"}, int64(3)},
+		{[]string{"ZADD", "z", "10", "a", "4", "d"}, int64(1)},
+		{[]string{"ZADD", "z", "CH", "20", "a", "5", "e"}, int64(2)},
+		{[]string{"ZADD", "z", "NX", "0", "a"}, int64(0)},
+		{[]string{"ZADD", "z", "XX", "GT", "1", "a"}, int64(0)},
+		{[]string{"ZCARD", "z"}, int64(5)},
+		{[]string{"ZSCORE", "z", "a"}, "20"},
+		{[]string{"ZSCORE", "z", "missing"}, nil},
+		{[]string{"ZSCORE", "nokey", "a"}, nil},
+		{[]string{"ZRANK", "z", "d"}, int64(2)},
+		{[]string{"ZRANK", "z", "missing"}, nil},
+		{[]string{"ZINCRBY", "z", "0.5", "b"}, "2.5"},
+		{[]string{"ZINCRBY", "z", "-inf", "c"}, "-inf"},
+		{[]string{"ZREM", "z", "c", "missing"}, int64(1)},
+		{[]string{"ZCARD", "nokey"}, int64(0)},
+	}
+
+	for _, tt := range tests {
+		if got := c.do(t, tt.args...); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%v: expected %#v, got %#v", tt.args, tt.expected, got)
+		}
+	}
+}
+
+func TestServer_ZRange(t *testing.T) {
+	c := startServer(t)
+	c.do(t, "ZADD", "z", "1", "a", "2", "b", "3", "c", "4", "d", "5", "e")
+	c.do(t, "ZADD", "lex", "0", "apple", "0", "banana", "0", "cherry", "0", "date")
+
+	tests := []struct {
+		args     []string
+		expected interface{}
+	}{
+		{[]string{"ZRANGE", "z", "0", "-1"}, bulks("a", "b", "c", "d", "e")},
+		{[]string{"ZRANGE", "z", "1", "2", "WITHSCORES"}, bulks("b", "2", "c", "3")},
+		{[]string{"ZRANGE", "z", "0", "1", "REV"}, bulks("e", "d")},
+		{[]string{"ZRANGE", "z", "(1", "3", "BYSCORE"}, bulks("b", "c")},
+		{[]string{"ZRANGE", "z", "-inf", "+inf", "BYSCORE", "LIMIT", "1", "2"}, bulks("b", "c")},
+		{[]string{"ZRANGE", "z", "+inf", "(3", "BYSCORE", "REV"}, bulks("e", "d")},
+		{[]string{"ZRANGE", "z", "5", "1", "BYSCORE", "REV", "LIMIT", "0", "1", "WITHSCORES"}, bulks("e", "5")},
+		{[]string{"ZRANGE", "lex", "[b", "(d", "BYLEX"}, bulks("banana", "cherry")},
+		{[]string{"ZRANGE", "lex", "+", "-", "BYLEX", "REV", "LIMIT", "1", "2"}, bulks("cherry", "banana")},
+		{[]string{"ZRANGE", "nokey", "0", "-1"}, bulks()},
+		{[]string{"ZRANGE", "z", "0", "-1", "LIMIT", "0", "1"}, respError(errLimitNoByType)},
+		{[]string{"ZRANGE", "z", "a", "b", "BYSCORE"}, respError(errMinMaxFloat)},
+		{[]string{"ZRANGE", "lex", "a", "b", "BYLEX"}, respError(errLexRange)},
+		{[]string{"ZRANGE", "z", "x", "1"}, respError(errNotInteger)},
+		{[]string{"ZRANGE", "z", "0", "1", "BOGUS"}, respError(errSyntax)},
+	}
+
+	for _, tt := range tests {
+		if got := c.do(t, tt.args...); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%v: expected %#v, got %#v", tt.args, tt.expected, got)
+		}
+	}
+}
+
+func TestServer_ZPopMin(t *testing.T) {
+	c := startServer(t)
+	c.do(t, "ZADD", "z", "1", "a", "2", "b", "3", "c")
+
+	if got := c.do(t, "ZPOPMIN", "z"); !reflect.DeepEqual(got, bulks("a", "1")) {
+		t.Errorf("Expected [a 1], got %#v", got)
+	}
+	if got := c.do(t, "ZPOPMIN", "z", "5"); !reflect.DeepEqual(got, bulks("b", "2", "c", "3")) {
+		t.Errorf("Expected [b 2 c 3], got %#v", got)
+	}
+	// Empty sets are deleted
+	if got := c.do(t, "ZPOPMIN", "z"); !reflect.DeepEqual(got, bulks()) {
+		t.Errorf("Expected an empty array, got %#v", got)
+	}
+	if got := c.do(t, "DEL", "z"); got != int64(0) {
+		t.Errorf("Expected the empty key to be gone, got %#v", got)
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	c := startServer(t)
+
+	tests := []struct {
+		args     []string
+		expected interface{}
+	}{
+		{[]string{"GET", "x"}, respError("ERR unknown command 'GET'")},
+		{[]string{"ZCARD"}, respError("ERR wrong number of arguments for 'zcard' command")},
+		{[]string{"ZADD", "z", "1"}, respError("ERR wrong number of arguments for 'zadd' command")},
+		{[]string{"ZADD", "z", "1", "a", "2"}, respError(errSyntax)},
+		{[]string{"ZADD", "z", "one", "a"}, respError(errNotFloat)},
+		{[]string{"ZADD", "z", "nan", "a"}, respError(errNotFloat)},
+		{[]string{"ZADD", "z", "NX", "XX", "1", "a"}, respError(errNXAndXX)},
+		{[]string{"ZADD", "z", "GT", "LT", "1", "a"}, respError(errGTLTNX)},
+		{[]string{"ZINCRBY", "z", "x", "a"}, respError(errNotFloat)},
+		{[]string{"ZADD", "z", "inf", "a"}, int64(1)},
+		{[]string{"ZINCRBY", "z", "-inf", "a"}, respError(errNaN)},
+		{[]string{"ZPOPMIN", "z", "-1"}, respError("ERR value is out of range, must be positive")},
+	}
+
+	for _, tt := range tests {
+		if got := c.do(t, tt.args...); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%v: expected %#v, got %#v", tt.args, tt.expected, got)
+		}
+	}
+
+	// Failed commands leave no empty key behind
+	if got := c.do(t, "DEL", "z"); got != int64(1) {
+		t.Errorf("Expected only the key with a member to exist, got %#v", got)
+	}
+}
+
+func TestServer_PipelineAndInline(t *testing.T) {
+	c := startServer(t)
+
+	// Several commands in a single write are answered in order
+	if _, err := io.WriteString(c.conn, "PING\r\nZADD z 1 a\r\n*2\r\n$5\r\nZCARD\r\n$1\r\nz\r\n"); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	for _, expected := range []interface{}{"PONG", int64(1), int64(1)} {
+		if got, err := c.read(); err != nil || got != expected {
+			t.Errorf("Expected %#v, got %#v (%v)", expected, got, err)
+		}
+	}
+
+	// Protocol errors are reported before the connection is closed
+	if _, err := io.WriteString(c.conn, "*1\r\n:1\r\n"); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if got, _ := c.read(); !strings.HasPrefix(fmt.Sprint(got), "ERR Protocol error") {
+		t.Errorf("Expected a protocol error, got %#v", got)
+	}
+}
+
+func TestServer_ConcurrentClients(t *testing.T) {
+	first := startServer(t)
+	addr := first.conn.RemoteAddr().String()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			c := dial(t, addr)
+			for i := 0; i < 50; i++ {
+				if err := c.send("ZINCRBY", "counter", "1", "hits"); err != nil {
+					t.Errorf("Unexpected error %v", err)
+					return
+				}
+				if _, err := c.read(); err != nil {
+					t.Errorf("Unexpected error %v", err)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if got := first.do(t, "ZSCORE", "counter", "hits"); got != "400" {
+		t.Errorf("Expected 400 increments, got %#v", got)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/ethan-gao-code/go-ds/bitset"
+)
+
+func main() {
+	// Create a new bit set and set a few bits
+	visited := bitset.New(128)
+	visited.Set(1)
+	visited.Set(5)
+	visited.Set(100)
+	fmt.Println("Visited:", visited)
+
+	// Test and clear individual bits
+	fmt.Println("Is 5 visited?", visited.Test(5))
+	visited.Clear(5)
+	fmt.Println("Is 5 visited after clearing?", visited.Test(5))
+
+	// Walk the set bits in ascending order
+	for i, ok := visited.NextSet(0); ok; i, ok = visited.NextSet(i + 1) {
+		fmt.Println("Set bit:", i)
+	}
+
+	// Combine bit sets
+	flags := bitset.From(1, 2, 3)
+	fmt.Println("And:", visited.And(flags))
+	fmt.Println("Or:", visited.Or(flags))
+	fmt.Println("Xor:", visited.Xor(flags))
+	fmt.Println("AndNot:", visited.AndNot(flags))
+
+	// Count the set bits
+	fmt.Println("Count:", visited.Count())
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/ethan-gao-code/go-ds/cache/lru"
+)
+
+func main() {
+	exampleForCount()
+	fmt.Println("==================== This is a split line ====================")
+	exampleForCost()
+}
+
+func exampleForCount() {
+	// Create a cache holding up to 3 entries
+	cache := lru.New[string, int](3)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+
+	// Reading a key makes it the most recently used
+	if v, ok := cache.Get("a"); ok {
+		fmt.Println("Value of a:", v)
+	}
+
+	// Adding a fourth entry evicts b, the least recently used
+	cache.Put("d", 4)
+	fmt.Println("Cache after adding d:", cache)
+
+	// Peek reads without touching the recency
+	v, ok := cache.Peek("c")
+	fmt.Println("Peek c:", v, ok)
+
+	// Statistics of the reads
+	cache.Get("b")
+	stats := cache.Stats()
+	fmt.Printf("Hits: %d, misses: %d, hit ratio: %.2f\n", stats.Hits, stats.Misses, stats.HitRatio())
+}
+
+func exampleForCost() {
+	// Bound the cache by the total size of the values, and log the evictions
+	cache := lru.NewSyncWithOptions[string, string](16,
+		func(key, value string) int64 { return int64(len(value)) },
+		func(key, value string) { fmt.Println("Evicted:", key) },
+	)
+	cache.Put("greeting", "hello")
+	cache.Put("subject", "world")
+	cache.Put("sentence", "hello, world")
+	fmt.Println("Cache:", cache, "cost:", cache.Cost())
+
+	// Shrinking the cache evicts the least recently used entries
+	evicted := cache.Resize(4)
+	fmt.Println("Entries evicted by Resize:", evicted, "cache:", cache)
+}
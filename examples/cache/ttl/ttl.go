@@ -0,0 +1,65 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethan-gao-code/go-ds/cache/ttl"
+)
+
+func main() {
+	exampleForExpiry()
+	fmt.Println("==================== This is a split line ====================")
+	exampleForSweeper()
+}
+
+func exampleForExpiry() {
+	// A fake clock makes the expiry visible without waiting
+	clock := ttl.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	sessions := ttl.NewWithOptions[string, string](100, 30*time.Minute, clock,
+		func(key, value string, reason ttl.EvictionReason) {
+			fmt.Printf("Session %s left the cache: %s\n", key, reason)
+		})
+
+	sessions.Put("alice", "token-a")
+	sessions.PutWithTTL("bob", "token-b", 5*time.Minute)
+
+	// Check the time left on a session
+	clock.Advance(4 * time.Minute)
+	if token, left, ok := sessions.GetWithTTL("bob"); ok {
+		fmt.Println("Bob's token:", token, "expires in", left)
+	}
+
+	// Touch extends a session for another TTL
+	sessions.Touch("bob")
+	clock.Advance(4 * time.Minute)
+	fmt.Println("Bob still logged in:", sessions.Contains("bob"))
+
+	// Lookups remove the expired sessions
+	clock.Advance(2 * time.Minute)
+	_, ok := sessions.Get("bob")
+	fmt.Println("Bob still logged in:", ok)
+
+	sessions.Remove("alice")
+}
+
+func exampleForSweeper() {
+	clock := ttl.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	cache := ttl.NewWithOptions[int, string](10, time.Second, clock, nil)
+	for i := 0; i < 5; i++ {
+		cache.Put(i, fmt.Sprint("value ", i))
+	}
+
+	// The sweeper removes expired entries in the background
+	cache.StartSweeper(time.Second)
+	defer cache.StopSweeper()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+	clock.BlockUntil(1)
+	fmt.Println("Entries left after the sweep:", cache.Len())
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethan-gao-code/go-ds/lists/concurrentskiplist"
+)
+
+func main() {
+	// Create a new lock-free skip list, no locking is needed around it
+	l := concurrentskiplist.New[int, string]()
+
+	// Add keys from several goroutines at once
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := g; i < 20; i += 4 {
+				l.Add(i, fmt.Sprintf("value-%d", i))
+			}
+		}(g)
+	}
+	wg.Wait()
+	fmt.Println("Number of keys:", l.Len())
+
+	// Keys come out in ascending order
+	fmt.Println("Keys:", l.Keys())
+
+	// Look up and remove keys
+	if value, ok := l.Find(7); ok {
+		fmt.Println("Value of 7:", value)
+	}
+	fmt.Println("Removed 7:", l.Remove(7))
+	fmt.Println("Contains 7:", l.Contains(7))
+}
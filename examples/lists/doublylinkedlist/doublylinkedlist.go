@@ -14,6 +14,12 @@ func main() {
 	exampleForImpl()
 	fmt.Println("==================== This is a split line ====================")
 	exampleForGenericImpl()
+	fmt.Println("==================== This is a split line ====================")
+	exampleForNodeHandles()
+	fmt.Println("==================== This is a split line ====================")
+	exampleForSplice()
+	fmt.Println("==================== This is a split line ====================")
+	exampleForSort()
 }
 
 func exampleForImpl() {
@@ -99,3 +105,85 @@ func exampleForGenericImpl() {
 	// Print the list after all the operations
 	fmt.Println("List after operations:", list.String())
 }
+
+func exampleForNodeHandles() {
+	// Keep the nodes returned by AddLast to work on them in O(1)
+	list := doublylinkedlist.NewGenericList[string]()
+	a := list.AddLast("a")
+	c := list.AddLast("c")
+	list.InsertBefore("b", c)
+	fmt.Println("List with node handles:", list)
+
+	// Move a node without searching for it
+	list.MoveToBack(a)
+	fmt.Println("After moving a to the back:", list)
+
+	// Remove a node from the middle
+	list.RemoveNode(c)
+	fmt.Println("After removing c:", list)
+
+	// Walk the nodes from the front
+	for node := list.Front(); node != nil; node = node.Next() {
+		fmt.Println("Node value:", node.Value())
+	}
+}
+
+func exampleForSplice() {
+	list := doublylinkedlist.NewGenericList[int]()
+	for i := 1; i <= 3; i++ {
+		list.AddLast(i)
+	}
+	other := doublylinkedlist.NewGenericList[int]()
+	for i := 4; i <= 6; i++ {
+		other.AddLast(i)
+	}
+
+	// Append all the elements of other in O(1), other is left empty
+	list.Concat(other)
+	fmt.Println("After concat:", list)
+
+	// Cut the list in two
+	rest, _ := list.SplitAt(4)
+	fmt.Println("After split:", list, rest)
+
+	// Put the cut part back in the middle
+	_ = list.SpliceAt(1, rest)
+	fmt.Println("After splice:", list)
+
+	// Reverse the list and rotate it by one element
+	list.Reverse()
+	fmt.Println("After reverse:", list)
+	list.Rotate(1)
+	fmt.Println("After rotate:", list)
+}
+
+func exampleForSort() {
+	less := func(a, b string) bool { return len(a) < len(b) }
+	list := doublylinkedlist.NewGenericList[string]()
+	for _, word := range []string{"banana", "fig", "apple", "kiwi", "pear"} {
+		list.AddLast(word)
+	}
+
+	// Sort by length, words of the same length keep their order
+	list.Sort(less)
+	fmt.Println("Sorted by length:", list, list.IsSorted(less))
+
+	// Keep the list sorted while adding
+	list.InsertSorted("plum", less)
+	fmt.Println("After inserting plum:", list)
+
+	// Merge another sorted list
+	other := doublylinkedlist.NewGenericList[string]()
+	other.AddLast("date")
+	other.AddLast("cherry")
+	list.MergeSorted(other, less)
+	fmt.Println("After merging:", list)
+
+	// The untyped list sorts with utils.CompareObjects when no function is given
+	numbers := doublylinkedlist.New()
+	for _, n := range []int{3, 1, 2} {
+		numbers.AddLast(n)
+	}
+	numbers.Sort(nil)
+	fmt.Println("Sorted numbers:", numbers)
+}
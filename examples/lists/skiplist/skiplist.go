@@ -5,6 +5,7 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"math/rand"
 	"time"
@@ -14,6 +15,9 @@ import (
 
 func main() {
 	exampleForImpl()
+	exampleForSortedSet()
+	exampleForGeneric()
+	exampleForSnapshot()
 }
 
 func exampleForImpl() {
@@ -73,6 +77,13 @@ func exampleForImpl() {
 		fmt.Println("\nNode not found at the given rank")
 	}
 
+	// Walk the nodes from a given score with an iterator
+	fmt.Println("\nNodes from score 2.0:")
+	it := sl.Iterator()
+	for ok := it.Seek(2.0); ok; ok = it.Next() {
+		fmt.Printf("score %.2f, object %s\n", it.Score(), it.Obj())
+	}
+
 	// Check the size of the skip list
 	fmt.Printf("\nSize of the skip list: %d\n", sl.Size())
 
@@ -83,3 +94,69 @@ func exampleForImpl() {
 		fmt.Println("\nThe skip list is not empty.")
 	}
 }
+
+func exampleForSortedSet() {
+	// Create a new sorted set
+	z := skiplist.NewSortedSet()
+
+	// Add members with their scores
+	_, _ = z.ZAdd(0,
+		skiplist.Element{Score: 100, Member: "alice"},
+		skiplist.Element{Score: 80, Member: "bob"},
+		skiplist.Element{Score: 120, Member: "carol"},
+	)
+	fmt.Println("\nLeaderboard:", z)
+
+	// Update a score by member alone
+	score, _ := z.ZIncrBy(50, "bob")
+	fmt.Printf("\nbob's new score: %.0f\n", score)
+
+	// Only raise scores with the GT flag
+	_, _ = z.ZAdd(skiplist.ZAddGT, skiplist.Element{Score: 90, Member: "alice"})
+	aliceScore, _ := z.ZScore("alice")
+	fmt.Printf("alice's score after a lower GT update: %.0f\n", aliceScore)
+
+	// Look up ranks by member
+	fmt.Println("bob's rank (ascending):", z.ZRank("bob"))
+	fmt.Println("bob's rank (descending):", z.ZRevRank("bob"))
+
+	// Remove a member
+	z.ZRem("carol")
+	fmt.Println("Members after removing carol:", z.ZCard())
+}
+
+func exampleForGeneric() {
+	// Create a skip list with int64 scores and string members, ordered without reflection
+	sl := skiplist.NewOrdered[int64, string]()
+	sl.Add(1700000300, "job-3")
+	sl.Add(1700000100, "job-1")
+	sl.Add(1700000200, "job-2")
+
+	// Typed range query: every job due up to a timestamp
+	due := sl.RangeByScore(skiplist.GenericScoreRange[int64]{MinUnbounded: true, Max: 1700000200}, 0, -1)
+	for _, node := range due {
+		fmt.Printf("\n%s is due at %d", node.GetMember(), node.GetScore())
+	}
+	fmt.Println()
+}
+
+func exampleForSnapshot() {
+	sl := skiplist.New()
+	sl.Add(300, "alice")
+	sl.Add(120, "bob")
+
+	// Save the leaderboard, a file works the same way as the buffer
+	var buf bytes.Buffer
+	if _, err := sl.WriteToWithCodec(&buf, skiplist.StringCodec{}); err != nil {
+		fmt.Println("Snapshot failed:", err)
+		return
+	}
+
+	// Restore it after a restart, the list is rebuilt in O(n)
+	restored := skiplist.New()
+	if _, err := restored.ReadFromWithCodec(&buf, skiplist.StringCodec{}); err != nil {
+		fmt.Println("Restore failed:", err)
+		return
+	}
+	fmt.Println("\nRestored:", restored)
+}
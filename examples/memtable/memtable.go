@@ -0,0 +1,72 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethan-gao-code/go-ds/memtable"
+)
+
+func main() {
+	// Write to the memtable, every write carries the sequence number of its log record
+	mt := memtable.New()
+	mt.Put(1, []byte("apple"), []byte("red"))
+	mt.Put(2, []byte("banana"), []byte("yellow"))
+	mt.Put(3, []byte("cherry"), []byte("dark red"))
+	mt.Delete(4, []byte("banana"))
+	fmt.Println(mt)
+	fmt.Println("Approximate size in bytes:", mt.ApproximateSize())
+
+	// Flush the memtable to an SSTable file once it is full
+	dir, err := os.MkdirTemp("", "memtable")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "000001.sst")
+
+	f, err := os.Create(path)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	if _, err := mt.Flush(f); err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	f.Close()
+
+	// Open the table and read it back
+	f, err = os.Open(path)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	defer f.Close()
+	info, _ := f.Stat()
+	st, err := memtable.Open(f, info.Size())
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+
+	if entry, ok, _ := st.Get([]byte("cherry")); ok {
+		fmt.Printf("\ncherry: %s\n", entry.Value)
+	}
+	if entry, ok, _ := st.Get([]byte("banana")); ok && entry.Tombstone {
+		fmt.Println("banana was deleted at sequence", entry.Seq)
+	}
+	fmt.Println("durian may exist:", st.MayContain([]byte("durian")))
+
+	// Range scan, tombstones included
+	_ = st.Scan([]byte("a"), []byte("c"), func(entry memtable.Entry) bool {
+		fmt.Printf("scan: %s (deleted: %v)\n", entry.Key, entry.Tombstone)
+		return true
+	})
+}
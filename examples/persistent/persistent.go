@@ -0,0 +1,37 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/ethan-gao-code/go-ds/persistent"
+)
+
+func main() {
+	// Create a persistent map, every Put returns a new version
+	v1 := persistent.NewMap[string, int]().Put("timeout", 30)
+	v2 := v1.Put("retries", 3)
+	fmt.Println("Version 1:", v1)
+	fmt.Println("Version 2:", v2)
+
+	// Old versions are never modified, so they can be shared freely
+	v3 := v2.Remove("timeout")
+	fmt.Println("Version 2 still has timeout?", v2.Contains("timeout"))
+	fmt.Println("Version 3 has timeout?", v3.Contains("timeout"))
+
+	// Use a builder for bulk construction
+	b := persistent.NewMapBuilder[string, int]()
+	for i, name := range []string{"a", "b", "c"} {
+		b.Put(name, i)
+	}
+	fmt.Println("Built map:", b.Map())
+
+	// Persistent sets work the same way
+	s1 := persistent.NewSet("read", "write")
+	s2 := s1.Add("admin")
+	fmt.Println("Set 1:", s1)
+	fmt.Println("Set 2:", s2)
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/ethan-gao-code/go-ds/unionfind"
+)
+
+func main() {
+	// Create a union-find structure with a few packages
+	uf := unionfind.New("app", "db", "cache", "log")
+	fmt.Println("Initial groups:", uf)
+
+	// Connect packages that depend on each other
+	uf.Union("app", "db")
+	uf.Union("db", "cache")
+
+	// Union adds elements that have not been seen yet
+	uf.Union("log", "metrics")
+	fmt.Println("Groups after unions:", uf)
+
+	// Check if two packages are in the same component
+	fmt.Println("Are app and cache connected?", uf.Connected("app", "cache"))
+	fmt.Println("Are app and log connected?", uf.Connected("app", "log"))
+
+	// Get the size of a component and the number of components
+	fmt.Println("Size of app's component:", uf.SetSize("app"))
+	fmt.Println("Number of components:", uf.Count())
+
+	// Materialize the components as sets
+	for i, group := range uf.Groups() {
+		fmt.Printf("Component %d: %v\n", i, group)
+	}
+}
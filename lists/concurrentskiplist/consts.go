@@ -0,0 +1,19 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package concurrentskiplist
+
+const (
+	// MaxLevel defines the maximum number of levels in the skip list
+	MaxLevel = 16
+	// Probability defines the probability factor for random level generation
+	Probability = 0.5
+)
+
+// Kinds of nodes, the sentinels bound every key
+const (
+	kindHead int8 = iota - 1 // Head sentinel, below every key
+	kindKey                  // Regular node holding a key
+	kindTail                 // Tail sentinel, above every key
+)
@@ -0,0 +1,254 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// Reference: Herlihy, Shavit, "The Art of Multiprocessor Programming", chapter 14 (LockFreeSkipList)
+// Reference: Fraser, "Practical lock-freedom", 2004
+
+package concurrentskiplist
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync/atomic"
+)
+
+// New creates a new empty lock-free skip list
+func New[K cmp.Ordered, V any]() *List[K, V] {
+	tail := newNode[K, V](kindTail, *new(K), *new(V), MaxLevel)
+	head := newNode[K, V](kindHead, *new(K), *new(V), MaxLevel)
+	for i := 0; i < MaxLevel; i++ {
+		tail.next[i].Store(&link[K, V]{})
+		head.next[i].Store(&link[K, V]{node: tail})
+	}
+	return &List[K, V]{head: head}
+}
+
+// newNode creates a node with level forward links, which are left unset
+func newNode[K cmp.Ordered, V any](kind int8, key K, value V, level int) *node[K, V] {
+	return &node[K, V]{
+		key:   key,
+		value: value,
+		kind:  kind,
+		next:  make([]atomic.Pointer[link[K, V]], level),
+	}
+}
+
+// randomLevel generates a random level for a new node
+// The global source of math/rand is safe for concurrent use.
+func randomLevel() int {
+	level := 1
+	for rand.Float64() < Probability && level < MaxLevel {
+		level++
+	}
+	return level
+}
+
+// Add adds key with its value and reports whether it was added
+// If the key is already present, the list is left unchanged and Add returns false.
+func (l *List[K, V]) Add(key K, value V) bool {
+	level := randomLevel()
+	var preds, succs [MaxLevel]*node[K, V]
+
+	for {
+		if l.find(key, &preds, &succs) {
+			return false
+		}
+
+		n := newNode(kindKey, key, value, level)
+		for i := 0; i < level; i++ {
+			n.next[i].Store(&link[K, V]{node: succs[i]})
+		}
+
+		// Linking the bottom level is the linearization point, the node is in the list from then on
+		if !casLink(&preds[0].next[0], succs[0], false, n, false) {
+			continue
+		}
+		l.length.Add(1)
+
+		// Link the upper levels, the node only serves as a shortcut there
+		for i := 1; i < level; i++ {
+			for {
+				current := n.next[i].Load()
+				if current.marked {
+					// The node is being removed, stop making it reachable
+					return true
+				}
+				if current.node != succs[i] && !casLink(&n.next[i], current.node, false, succs[i], false) {
+					continue
+				}
+				if casLink(&preds[i].next[i], succs[i], false, n, false) {
+					break
+				}
+				// The neighborhood changed, search again to refresh the predecessors
+				l.find(key, &preds, &succs)
+			}
+		}
+		return true
+	}
+}
+
+// Remove removes key and reports whether it was removed by this call
+func (l *List[K, V]) Remove(key K) bool {
+	var preds, succs [MaxLevel]*node[K, V]
+	if !l.find(key, &preds, &succs) {
+		return false
+	}
+	victim := succs[0]
+
+	// Mark the upper levels first so that no new link to the victim can be made there
+	for i := len(victim.next) - 1; i >= 1; i-- {
+		for {
+			current := victim.next[i].Load()
+			if current.marked || casLink(&victim.next[i], current.node, false, current.node, true) {
+				break
+			}
+		}
+	}
+
+	// Marking the bottom level is the linearization point, only one remover can succeed
+	for {
+		current := victim.next[0].Load()
+		if current.marked {
+			return false
+		}
+		if casLink(&victim.next[0], current.node, false, current.node, true) {
+			l.length.Add(-1)
+			// Physically unlink the victim, find snips every marked node on its way
+			l.find(key, &preds, &succs)
+			return true
+		}
+	}
+}
+
+// Find returns the value associated with key
+// The second return value is false if the key is not present. Find never blocks nor retries.
+func (l *List[K, V]) Find(key K) (V, bool) {
+	pred := l.head
+	var curr *node[K, V]
+	for i := MaxLevel - 1; i >= 0; i-- {
+		curr = pred.next[i].Load().node
+		for {
+			// Step over logically deleted nodes without unlinking them
+			succ := curr.next[i].Load()
+			for succ.marked {
+				curr = succ.node
+				succ = curr.next[i].Load()
+			}
+			if !curr.less(key) {
+				break
+			}
+			pred, curr = curr, succ.node
+		}
+	}
+
+	if curr.kind != kindKey || cmp.Compare(curr.key, key) != 0 {
+		var zero V
+		return zero, false
+	}
+	return curr.value, true
+}
+
+// Contains checks if key is present
+func (l *List[K, V]) Contains(key K) bool {
+	_, found := l.Find(key)
+	return found
+}
+
+// Each calls fn for every key in ascending order until fn returns false
+// The iteration is weakly consistent: it never returns a key twice nor out of order,
+// it sees every key present for its whole duration, and may or may not see concurrent changes.
+func (l *List[K, V]) Each(fn func(key K, value V) bool) {
+	for x := l.head.next[0].Load().node; x.kind != kindTail; {
+		next := x.next[0].Load()
+		if !next.marked && !fn(x.key, x.value) {
+			return
+		}
+		x = next.node
+	}
+}
+
+// Keys returns the keys in ascending order
+func (l *List[K, V]) Keys() []K {
+	var keys []K
+	l.Each(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Len returns the number of keys in the list
+// Under concurrent updates, it reflects the updates that completed when it was called.
+func (l *List[K, V]) Len() int {
+	return int(l.length.Load())
+}
+
+// IsEmpty checks if the list is empty
+func (l *List[K, V]) IsEmpty() bool {
+	return l.Len() == 0
+}
+
+// String returns a string representation of the list
+func (l *List[K, V]) String() string {
+	var elements []string
+	l.Each(func(key K, value V) bool {
+		elements = append(elements, fmt.Sprintf("%v: %v", key, value))
+		return true
+	})
+	return fmt.Sprintf("ConcurrentSkipList elements: [%s]", strings.Join(elements, ", "))
+}
+
+// find fills preds and succs with the neighbors of key at each level and reports whether key is present
+// On its way, it physically unlinks every logically deleted node, restarting if a CAS fails.
+func (l *List[K, V]) find(key K, preds, succs *[MaxLevel]*node[K, V]) bool {
+retry:
+	for {
+		pred := l.head
+		for i := MaxLevel - 1; i >= 0; i-- {
+			curr := pred.next[i].Load().node
+			for {
+				succ := curr.next[i].Load()
+				for succ.marked {
+					// curr is deleted, unlink it from pred at this level
+					if !casLink(&pred.next[i], curr, false, succ.node, false) {
+						continue retry
+					}
+					curr = succ.node
+					succ = curr.next[i].Load()
+				}
+				if !curr.less(key) {
+					break
+				}
+				pred, curr = curr, succ.node
+			}
+			preds[i], succs[i] = pred, curr
+		}
+		return succs[0].kind == kindKey && cmp.Compare(succs[0].key, key) == 0
+	}
+}
+
+// less checks if the node comes before key, the sentinels bound every key
+func (n *node[K, V]) less(key K) bool {
+	switch n.kind {
+	case kindHead:
+		return true
+	case kindTail:
+		return false
+	default:
+		return cmp.Compare(n.key, key) < 0
+	}
+}
+
+// casLink replaces the link at p with next/mark if it currently holds expectedNext/expectedMark
+func casLink[K cmp.Ordered, V any](p *atomic.Pointer[link[K, V]], expectedNext *node[K, V], expectedMark bool,
+	next *node[K, V], mark bool) bool {
+	current := p.Load()
+	if current.node != expectedNext || current.marked != expectedMark {
+		return false
+	}
+	// Links are immutable, so comparing the pointer also compares the node and the mark
+	return p.CompareAndSwap(current, &link[K, V]{node: next, marked: mark})
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package concurrentskiplist
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestList_AddRemoveFind(t *testing.T) {
+	l := New[int, string]()
+	if !l.IsEmpty() || l.Len() != 0 {
+		t.Errorf("Expected new list to be empty")
+	}
+
+	if !l.Add(2, "two") || !l.Add(1, "one") || !l.Add(3, "three") {
+		t.Errorf("Expected new keys to be added")
+	}
+	if l.Add(2, "deux") {
+		t.Errorf("Expected an existing key not to be added again")
+	}
+	if value, ok := l.Find(2); !ok || value != "two" {
+		t.Errorf("Expected 2=two, got %v (%v)", value, ok)
+	}
+	if _, ok := l.Find(4); ok || l.Contains(0) {
+		t.Errorf("Expected missing keys not to be found")
+	}
+
+	if !l.Remove(2) || l.Remove(2) || l.Remove(42) {
+		t.Errorf("Unexpected Remove results")
+	}
+	if l.Contains(2) || l.Len() != 2 {
+		t.Errorf("Expected 2 to be removed, got %v", l)
+	}
+
+	// A removed key can be added again
+	if !l.Add(2, "again") {
+		t.Errorf("Expected a removed key to be added again")
+	}
+	expected := "ConcurrentSkipList elements: [1: one, 2: again, 3: three]"
+	if l.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, l.String())
+	}
+}
+
+func TestList_Each(t *testing.T) {
+	l := New[string, int]()
+	for i, key := range []string{"d", "b", "a", "c"} {
+		l.Add(key, i)
+	}
+
+	if keys := l.Keys(); !reflect.DeepEqual(keys, []string{"a", "b", "c", "d"}) {
+		t.Errorf("Expected [a b c d], got %v", keys)
+	}
+
+	visited := 0
+	l.Each(func(string, int) bool {
+		visited++
+		return visited < 2
+	})
+	if visited != 2 {
+		t.Errorf("Expected Each to stop after 2 keys, visited %d", visited)
+	}
+}
+
+func TestList_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	l := New[int, int]()
+	reference := make(map[int]int)
+
+	for i := 0; i < 5000; i++ {
+		key := r.Intn(500)
+		if r.Intn(3) == 0 {
+			_, present := reference[key]
+			if l.Remove(key) != present {
+				t.Fatalf("Remove(%d): expected %v", key, present)
+			}
+			delete(reference, key)
+		} else {
+			_, present := reference[key]
+			if l.Add(key, i) == present {
+				t.Fatalf("Add(%d): expected %v", key, !present)
+			}
+			if !present {
+				reference[key] = i
+			}
+		}
+	}
+
+	keys := make([]int, 0, len(reference))
+	for key := range reference {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	if got := l.Keys(); !reflect.DeepEqual(got, keys) || l.Len() != len(keys) {
+		t.Fatalf("Expected keys %v, got %v", keys, got)
+	}
+	for key, value := range reference {
+		if got, ok := l.Find(key); !ok || got != value {
+			t.Errorf("Expected %d=%d, got %v (%v)", key, value, got, ok)
+		}
+	}
+}
+
+func TestList_ConcurrentAdd(t *testing.T) {
+	const goroutines, perGoroutine = 8, 1000
+	l := New[int, int]()
+
+	// Every goroutine adds its own keys, interleaved with the others
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				if !l.Add(i*goroutines+g, g) {
+					t.Errorf("Expected key %d to be added", i*goroutines+g)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	keys := l.Keys()
+	if len(keys) != goroutines*perGoroutine || l.Len() != len(keys) {
+		t.Fatalf("Expected %d keys, got %d (Len %d)", goroutines*perGoroutine, len(keys), l.Len())
+	}
+	for i, key := range keys {
+		if key != i {
+			t.Fatalf("Expected key %d at position %d, got %d", i, i, key)
+		}
+	}
+}
+
+func TestList_ConcurrentContention(t *testing.T) {
+	const goroutines, operations, keySpace = 8, 3000, 64
+	l := New[int, int]()
+
+	// For each key, successful adds and removes must alternate, whoever performs them
+	var added, removed [keySpace]atomic.Int64
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			r := rand.New(rand.NewSource(seed))
+			for i := 0; i < operations; i++ {
+				key := r.Intn(keySpace)
+				switch r.Intn(3) {
+				case 0:
+					if l.Add(key, key) {
+						added[key].Add(1)
+					}
+				case 1:
+					if l.Remove(key) {
+						removed[key].Add(1)
+					}
+				default:
+					if value, ok := l.Find(key); ok && value != key {
+						t.Errorf("Expected value %d for key %d, got %d", key, key, value)
+					}
+				}
+			}
+		}(int64(g))
+	}
+	wg.Wait()
+
+	present := 0
+	for key := 0; key < keySpace; key++ {
+		diff := added[key].Load() - removed[key].Load()
+		if diff != 0 && diff != 1 {
+			t.Fatalf("Key %d: %d successful adds and %d removes", key, added[key].Load(), removed[key].Load())
+		}
+		if l.Contains(key) != (diff == 1) {
+			t.Fatalf("Key %d: expected presence %v", key, diff == 1)
+		}
+		present += int(diff)
+	}
+	if l.Len() != present || len(l.Keys()) != present {
+		t.Fatalf("Expected %d keys, got Len %d and %d iterated keys", present, l.Len(), len(l.Keys()))
+	}
+}
+
+func TestList_ConcurrentIteration(t *testing.T) {
+	l := New[int, int]()
+	// Even keys are never removed, odd keys come and go
+	for i := 0; i < 200; i += 2 {
+		l.Add(i, i)
+	}
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			r := rand.New(rand.NewSource(seed))
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				key := r.Intn(100)*2 + 1
+				if r.Intn(2) == 0 {
+					l.Add(key, key)
+				} else {
+					l.Remove(key)
+				}
+			}
+		}(int64(g))
+	}
+
+	for round := 0; round < 200; round++ {
+		previous, evens := -1, 0
+		l.Each(func(key, value int) bool {
+			if key <= previous {
+				t.Errorf("Keys out of order: %d after %d", key, previous)
+			}
+			if key%2 == 0 {
+				evens++
+			}
+			previous = key
+			return true
+		})
+		// Keys present during the whole iteration must all be seen
+		if evens != 100 {
+			t.Errorf("Expected to see the 100 stable keys, saw %d", evens)
+		}
+	}
+	close(stop)
+	wg.Wait()
+}
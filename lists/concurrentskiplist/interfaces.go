@@ -0,0 +1,20 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package concurrentskiplist
+
+import "cmp"
+
+// ConcurrentSkipList defines the interface for an ordered map safe for concurrent use
+type ConcurrentSkipList[K cmp.Ordered, V any] interface {
+	Add(key K, value V) bool           // Adds a key if it is not present yet
+	Remove(key K) bool                 // Removes a key if it is present
+	Find(key K) (V, bool)              // Returns the value associated with a key
+	Contains(key K) bool               // Checks if a key is present
+	Each(fn func(key K, value V) bool) // Calls fn for every key in ascending order
+	Keys() []K                         // Returns the keys in ascending order
+	Len() int                          // Returns the number of keys
+	IsEmpty() bool                     // Checks if the list is empty
+	String() string                    // Returns a string representation of the list
+}
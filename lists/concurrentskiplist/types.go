@@ -0,0 +1,32 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package concurrentskiplist
+
+import (
+	"cmp"
+	"sync/atomic"
+)
+
+// List is a lock-free ordered map backed by a skip list
+// It is safe for concurrent use by multiple goroutines without additional locking.
+type List[K cmp.Ordered, V any] struct {
+	head   *node[K, V]  // Head sentinel, linked at every level
+	length atomic.Int64 // Number of keys in the list
+}
+
+// node represents a node of the skip list, its key and value never change once linked
+type node[K cmp.Ordered, V any] struct {
+	key   K                            // Key of the node
+	value V                            // Value associated with the key
+	kind  int8                         // Head sentinel, regular node or tail sentinel
+	next  []atomic.Pointer[link[K, V]] // Forward links at each level
+}
+
+// link is an immutable forward pointer paired with the deletion mark of the node owning it
+// Replacing the whole link with a CAS updates the pointer and the mark atomically.
+type link[K cmp.Ordered, V any] struct {
+	node   *node[K, V] // Next node at this level
+	marked bool        // Whether the node owning the link is logically deleted
+}
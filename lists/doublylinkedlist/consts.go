@@ -0,0 +1,14 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package doublylinkedlist
+
+import "errors"
+
+var (
+	// ErrIndexOutOfRange is returned when an index does not designate a position of the list
+	ErrIndexOutOfRange = errors.New("doublylinkedlist: index out of range")
+	// ErrSameList is returned when a list is spliced into itself
+	ErrSameList = errors.New("doublylinkedlist: cannot splice a list into itself")
+)
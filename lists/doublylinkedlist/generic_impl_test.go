@@ -634,3 +634,132 @@ func TestGenericList_String(t *testing.T) {
 		t.Errorf("Expected: %s, but got: %s", expected, result)
 	}
 }
+
+// checkGenericLinks verifies the links of the list in both directions against the expected values
+func checkGenericLinks[T comparable](t *testing.T, list *GenericList[T], expected ...T) {
+	t.Helper()
+	if list.Size() != len(expected) {
+		t.Fatalf("Expected size %d, got %d", len(expected), list.Size())
+	}
+	var prev *GenericNode[T]
+	node := list.Front()
+	for i, value := range expected {
+		if node == nil || node.Value() != value || node.Prev() != prev || !list.owns(node) {
+			t.Fatalf("Expected %v at index %d, got %v", value, i, list)
+		}
+		prev, node = node, node.Next()
+	}
+	if node != nil || list.Back() != prev {
+		t.Fatalf("Unexpected tail in %v", list)
+	}
+}
+
+func TestGenericList_NodeHandles(t *testing.T) {
+	list := NewGenericList[string]()
+	b := list.AddLast("b")
+	list.AddFirst("a")
+	d := list.AddLast("d")
+	c := list.InsertBefore("c", d)
+	list.InsertAfter("e", d)
+	checkGenericLinks(t, list, "a", "b", "c", "d", "e")
+
+	if list.InsertAfter("x", nil) != nil || list.InsertBefore("x", NewGenericList[string]().AddLast("y")) != nil {
+		t.Errorf("Expected inserting next to a foreign node to fail")
+	}
+	if value := list.RemoveNode(c); value != "c" {
+		t.Errorf("Expected to remove c, got %v", value)
+	}
+	if value := list.RemoveNode(c); value != "" {
+		t.Errorf("Expected the zero value when removing a detached node, got %v", value)
+	}
+	list.RemoveNode(b)
+	checkGenericLinks(t, list, "a", "d", "e")
+
+	// RemoveFirst and RemoveLast detach the nodes as well
+	list.RemoveLast()
+	if list.RemoveNode(d); list.Size() != 1 || list.PeekLast() != "a" {
+		t.Errorf("Unexpected list %v", list)
+	}
+}
+
+func TestGenericList_Move(t *testing.T) {
+	list := NewGenericList[int]()
+	nodes := make([]*GenericNode[int], 5)
+	for i := range nodes {
+		nodes[i] = list.AddLast(i)
+	}
+
+	list.MoveToFront(nodes[3])
+	checkGenericLinks(t, list, 3, 0, 1, 2, 4)
+	list.MoveToBack(nodes[0])
+	checkGenericLinks(t, list, 3, 1, 2, 4, 0)
+	list.MoveBefore(nodes[4], nodes[3])
+	checkGenericLinks(t, list, 4, 3, 1, 2, 0)
+	list.MoveAfter(nodes[3], nodes[0])
+	checkGenericLinks(t, list, 4, 1, 2, 0, 3)
+
+	list.MoveBefore(nodes[1], nodes[1])
+	list.MoveAfter(nodes[2], NewGenericList[int]().AddLast(9))
+	checkGenericLinks(t, list, 4, 1, 2, 0, 3)
+
+	// An LRU moves the accessed node to the front and evicts from the back
+	list.MoveToFront(nodes[0])
+	if evicted := list.RemoveNode(list.Back()); evicted != 3 {
+		t.Errorf("Expected to evict 3, got %d", evicted)
+	}
+	checkGenericLinks(t, list, 0, 4, 1, 2)
+}
+
+func TestGenericList_IndexOperations(t *testing.T) {
+	list := NewGenericList[int]()
+	if err := list.InsertAt(0, 1, 5); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if err := list.InsertAt(1, 2, 3, 4); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if err := list.InsertAt(6, 9); err != ErrIndexOutOfRange {
+		t.Errorf("Expected ErrIndexOutOfRange, got %v", err)
+	}
+	checkGenericLinks(t, list, 1, 2, 3, 4, 5)
+
+	if value, err := list.RemoveAt(4); err != nil || value != 5 {
+		t.Errorf("RemoveAt(4): expected 5, got %v, %v", value, err)
+	}
+	if value, err := list.RemoveAt(-1); err != ErrIndexOutOfRange || value != 0 {
+		t.Errorf("Expected the zero value and ErrIndexOutOfRange, got %v, %v", value, err)
+	}
+	if err := list.Set(0, 10); err != nil || list.GetByIndex(0) != 10 {
+		t.Errorf("Expected 10 at index 0, got %v", list)
+	}
+	if err := list.Set(4, 0); err != ErrIndexOutOfRange {
+		t.Errorf("Expected ErrIndexOutOfRange, got %v", err)
+	}
+	checkGenericLinks(t, list, 10, 2, 3, 4)
+
+	_ = list.InsertAt(4, 2)
+	if !list.Contains(2) || list.Contains(7) || list.LastIndexOf(2) != 4 || list.IndexOf(2) != 1 {
+		t.Errorf("Unexpected lookups in %v", list)
+	}
+	if !list.RemoveValue(2) || list.LastIndexOf(2) != 3 {
+		t.Errorf("Expected the first 2 to be removed, got %v", list)
+	}
+	if n := list.RemoveAll(func(value int) bool { return value < 5 }); n != 3 {
+		t.Errorf("Expected 3 removed elements, got %d", n)
+	}
+	checkGenericLinks(t, list, 10)
+}
+
+func TestGenericList_SubList(t *testing.T) {
+	list := NewGenericList[string]()
+	_ = list.InsertAt(0, "a", "b", "c", "d", "e")
+
+	sub, err := list.SubList(2, 5)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	checkGenericLinks(t, sub, "c", "d", "e")
+	if _, err := list.SubList(0, 6); err != ErrIndexOutOfRange {
+		t.Errorf("Expected ErrIndexOutOfRange, got %v", err)
+	}
+}
@@ -45,18 +45,9 @@ func (l *List) Add(value interface{}) {
 	l.AddFirst(value)
 }
 
-// AddFirst adds an element at the beginning of the list.
-func (l *List) AddFirst(value interface{}) {
-	node := &Node{value: value}
-	if l.IsEmpty() {
-		l.head = node
-		l.tail = node
-	} else {
-		node.next = l.head
-		l.head.prev = node
-		l.head = node
-	}
-	l.size++
+// AddFirst adds an element at the beginning of the list and returns its node.
+func (l *List) AddFirst(value interface{}) *Node {
+	return l.link(&Node{value: value}, nil, l.head)
 }
 
 // Append adds an element at the end (alias for AddLast).
@@ -64,18 +55,9 @@ func (l *List) Append(value interface{}) {
 	l.AddLast(value)
 }
 
-// AddLast adds an element at the end of the list.
-func (l *List) AddLast(value interface{}) {
-	node := &Node{value: value}
-	if l.IsEmpty() {
-		l.head = node
-		l.tail = node
-	} else {
-		l.tail.next = node
-		node.prev = l.tail
-		l.tail = node
-	}
-	l.size++
+// AddLast adds an element at the end of the list and returns its node.
+func (l *List) AddLast(value interface{}) *Node {
+	return l.link(&Node{value: value}, l.tail, nil)
 }
 
 // Prepend adds an element at the beginning (alias for AddFirst).
@@ -85,40 +67,12 @@ func (l *List) Prepend(value interface{}) {
 
 // RemoveFirst removes and returns the first element of the list.
 func (l *List) RemoveFirst() interface{} {
-	if l.IsEmpty() {
-		return nil
-	}
-
-	removedNode := l.head
-	if l.head == l.tail {
-		l.head = nil
-		l.tail = nil
-	} else {
-		l.head = l.head.next
-		l.head.prev = nil
-	}
-
-	l.size--
-	return removedNode.value
+	return l.RemoveNode(l.head)
 }
 
 // RemoveLast removes and returns the last element of the list.
 func (l *List) RemoveLast() interface{} {
-	if l.IsEmpty() {
-		return nil
-	}
-
-	removedNode := l.tail
-	if l.head == l.tail {
-		l.head = nil
-		l.tail = nil
-	} else {
-		l.tail = l.tail.prev
-		l.tail.next = nil
-	}
-
-	l.size--
-	return removedNode.value
+	return l.RemoveNode(l.tail)
 }
 
 // PeekFirst returns the first element of the doubly linked list without removing it.
@@ -143,23 +97,7 @@ func (l *List) GetByIndex(index int) interface{} {
 	if index < 0 || index >= l.size {
 		return nil
 	}
-
-	// Start from the head or tail depending on the index location to optimize search
-	if index < l.size/2 {
-		// Traverse from the head
-		current := l.head
-		for i := 0; i < index; i++ {
-			current = current.next
-		}
-		return current.value
-	} else {
-		// Traverse from the tail
-		current := l.tail
-		for i := l.size - 1; i > index; i-- {
-			current = current.prev
-		}
-		return current.value
-	}
+	return l.nodeAt(index).value
 }
 
 // IndexOf returns the index of the first occurrence of the given value.
@@ -175,6 +113,126 @@ func (l *List) IndexOf(value interface{}) int {
 	return -1
 }
 
+// LastIndexOf returns the index of the last occurrence of the given value.
+// Returns -1 if the value is not found.
+func (l *List) LastIndexOf(value interface{}) int {
+	current := l.tail
+	for i := l.size - 1; current != nil; i-- {
+		if current.value == value {
+			return i
+		}
+		current = current.prev
+	}
+	return -1
+}
+
+// Contains checks if the list holds the given value.
+func (l *List) Contains(value interface{}) bool {
+	return l.IndexOf(value) != -1
+}
+
+// InsertAt inserts values at the specified index, in order, shifting the following elements.
+// An index equal to Size appends the values. Returns ErrIndexOutOfRange for other indexes.
+func (l *List) InsertAt(index int, values ...interface{}) error {
+	if index < 0 || index > l.size {
+		return ErrIndexOutOfRange
+	}
+
+	var prev, next *Node
+	if index == l.size {
+		prev = l.tail
+	} else {
+		next = l.nodeAt(index)
+		prev = next.prev
+	}
+	for _, value := range values {
+		prev = l.link(&Node{value: value}, prev, next)
+	}
+	return nil
+}
+
+// RemoveAt removes and returns the element at the specified index.
+// Returns ErrIndexOutOfRange if the index is out of bounds.
+func (l *List) RemoveAt(index int) (interface{}, error) {
+	if index < 0 || index >= l.size {
+		var zeroValue interface{}
+		return zeroValue, ErrIndexOutOfRange
+	}
+	return l.RemoveNode(l.nodeAt(index)), nil
+}
+
+// Set replaces the element at the specified index.
+// Returns ErrIndexOutOfRange if the index is out of bounds.
+func (l *List) Set(index int, value interface{}) error {
+	if index < 0 || index >= l.size {
+		return ErrIndexOutOfRange
+	}
+	l.nodeAt(index).value = value
+	return nil
+}
+
+// RemoveValue removes the first occurrence of the given value.
+// Returns false if the value is not found.
+func (l *List) RemoveValue(value interface{}) bool {
+	for current := l.head; current != nil; current = current.next {
+		if current.value == value {
+			l.unlink(current)
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveAll removes every element for which pred returns true and returns how many were removed.
+func (l *List) RemoveAll(pred func(value interface{}) bool) int {
+	removed := 0
+	for current := l.head; current != nil; {
+		next := current.next
+		if pred(current.value) {
+			l.unlink(current)
+			removed++
+		}
+		current = next
+	}
+	return removed
+}
+
+// SubList returns a new list holding the elements from index from (inclusive) to index to (exclusive).
+// Returns ErrIndexOutOfRange unless 0 <= from <= to <= Size.
+func (l *List) SubList(from, to int) (*List, error) {
+	if from < 0 || to > l.size || from > to {
+		return nil, ErrIndexOutOfRange
+	}
+
+	sub := New()
+	if from == to {
+		return sub, nil
+	}
+	current := l.nodeAt(from)
+	for i := from; i < to; i++ {
+		sub.AddLast(current.value)
+		current = current.next
+	}
+	return sub, nil
+}
+
+// nodeAt returns the node at the specified index, which must be within bounds.
+// It starts from the head or the tail, whichever is nearer.
+func (l *List) nodeAt(index int) *Node {
+	if index < l.size/2 {
+		current := l.head
+		for i := 0; i < index; i++ {
+			current = current.next
+		}
+		return current
+	}
+	current := l.tail
+	for i := l.size - 1; i > index; i-- {
+		current = current.prev
+	}
+	return current
+}
+
 // String returns a string representation of the list.
 func (l *List) String() string {
 	// Create a slice to hold string representations of the elements
@@ -190,3 +248,146 @@ func (l *List) String() string {
 	// Join all the elements with commas and wrap them in square brackets
 	return fmt.Sprintf("List elements: [%s]", strings.Join(elements, ", "))
 }
+
+// Value returns the value stored in the node.
+func (n *Node) Value() interface{} {
+	return n.value
+}
+
+// Next returns the next node, nil if n is the last node or was removed.
+func (n *Node) Next() *Node {
+	return n.next
+}
+
+// Prev returns the previous node, nil if n is the first node or was removed.
+func (n *Node) Prev() *Node {
+	return n.prev
+}
+
+// Front returns the first node of the list, nil if the list is empty.
+func (l *List) Front() *Node {
+	return l.head
+}
+
+// Back returns the last node of the list, nil if the list is empty.
+func (l *List) Back() *Node {
+	return l.tail
+}
+
+// InsertBefore inserts a value right before mark and returns its node.
+// Returns nil if mark does not belong to the list.
+func (l *List) InsertBefore(value interface{}, mark *Node) *Node {
+	if !l.owns(mark) {
+		return nil
+	}
+	return l.link(&Node{value: value}, mark.prev, mark)
+}
+
+// InsertAfter inserts a value right after mark and returns its node.
+// Returns nil if mark does not belong to the list.
+func (l *List) InsertAfter(value interface{}, mark *Node) *Node {
+	if !l.owns(mark) {
+		return nil
+	}
+	return l.link(&Node{value: value}, mark, mark.next)
+}
+
+// RemoveNode removes a node from the list in O(1) and returns its value.
+// Returns nil if the node does not belong to the list.
+func (l *List) RemoveNode(node *Node) interface{} {
+	if !l.owns(node) {
+		return nil
+	}
+	l.unlink(node)
+	return node.value
+}
+
+// MoveToFront moves a node to the beginning of the list.
+// Nothing happens if the node does not belong to the list.
+func (l *List) MoveToFront(node *Node) {
+	if !l.owns(node) || node == l.head {
+		return
+	}
+	l.link(l.unlink(node), nil, l.head)
+}
+
+// MoveToBack moves a node to the end of the list.
+// Nothing happens if the node does not belong to the list.
+func (l *List) MoveToBack(node *Node) {
+	if !l.owns(node) || node == l.tail {
+		return
+	}
+	l.link(l.unlink(node), l.tail, nil)
+}
+
+// MoveBefore moves a node right before mark.
+// Nothing happens if either node does not belong to the list, or if they are the same node.
+func (l *List) MoveBefore(node, mark *Node) {
+	if !l.owns(node) || !l.owns(mark) || node == mark {
+		return
+	}
+	l.unlink(node)
+	l.link(node, mark.prev, mark)
+}
+
+// MoveAfter moves a node right after mark.
+// Nothing happens if either node does not belong to the list, or if they are the same node.
+func (l *List) MoveAfter(node, mark *Node) {
+	if !l.owns(node) || !l.owns(mark) || node == mark {
+		return
+	}
+	l.unlink(node)
+	l.link(node, mark, mark.next)
+}
+
+// owns checks if a node belongs to the list.
+func (l *List) owns(node *Node) bool {
+	if node == nil || node.owner == nil || l.owner == nil {
+		return false
+	}
+	// Point the node straight to its current owner to skip the forwarding chain next time
+	node.owner = node.owner.root()
+	return node.owner == l.owner
+}
+
+// nodeOwner returns the owner given to the nodes of the list, creating it on first use.
+func (l *List) nodeOwner() *owner {
+	if l.owner == nil {
+		l.owner = &owner{}
+	}
+	return l.owner
+}
+
+// link inserts a node between prev and next, a nil prev or next stands for an end of the list.
+func (l *List) link(node, prev, next *Node) *Node {
+	node.prev, node.next, node.owner = prev, next, l.nodeOwner()
+	if prev != nil {
+		prev.next = node
+	} else {
+		l.head = node
+	}
+	if next != nil {
+		next.prev = node
+	} else {
+		l.tail = node
+	}
+	l.size++
+	return node
+}
+
+// unlink removes a node from the list and clears its links so that it cannot be used with the list anymore.
+func (l *List) unlink(node *Node) *Node {
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		l.head = node.next
+	}
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		l.tail = node.prev
+	}
+	node.prev, node.next, node.owner = nil, nil, nil
+	l.size--
+	return node
+}
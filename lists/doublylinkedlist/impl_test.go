@@ -355,3 +355,186 @@ func TestList_String(t *testing.T) {
 		t.Errorf("Expected: %s, but got: %s", expected, result)
 	}
 }
+
+// checkLinks verifies the links of the list in both directions against the expected values
+func checkLinks(t *testing.T, list *List, expected ...interface{}) {
+	t.Helper()
+	if list.Size() != len(expected) {
+		t.Fatalf("Expected size %d, got %d", len(expected), list.Size())
+	}
+	var prev *Node
+	node := list.Front()
+	for i, value := range expected {
+		if node == nil || node.Value() != value || node.Prev() != prev || !list.owns(node) {
+			t.Fatalf("Expected %v at index %d, got %v", value, i, list)
+		}
+		prev, node = node, node.Next()
+	}
+	if node != nil || list.Back() != prev {
+		t.Fatalf("Unexpected tail in %v", list)
+	}
+}
+
+func TestList_NodeHandles(t *testing.T) {
+	list := New()
+	b := list.AddLast("b")
+	a := list.AddFirst("a")
+	d := list.AddLast("d")
+	c := list.InsertBefore("c", d)
+	e := list.InsertAfter("e", d)
+	checkLinks(t, list, "a", "b", "c", "d", "e")
+
+	if list.InsertAfter("x", nil) != nil || list.InsertBefore("x", New().AddLast("y")) != nil {
+		t.Errorf("Expected inserting next to a foreign node to fail")
+	}
+
+	if value := list.RemoveNode(c); value != "c" {
+		t.Errorf("Expected to remove c, got %v", value)
+	}
+	checkLinks(t, list, "a", "b", "d", "e")
+	// A removed node is detached and cannot be removed twice
+	if c.Next() != nil || c.Prev() != nil || list.RemoveNode(c) != nil {
+		t.Errorf("Expected a removed node to be detached")
+	}
+
+	list.RemoveNode(a)
+	list.RemoveNode(e)
+	checkLinks(t, list, "b", "d")
+	list.RemoveNode(b)
+	list.RemoveNode(d)
+	checkLinks(t, list)
+}
+
+func TestList_Move(t *testing.T) {
+	list := New()
+	nodes := make([]*Node, 5)
+	for i := range nodes {
+		nodes[i] = list.AddLast(i)
+	}
+
+	list.MoveToFront(nodes[3])
+	checkLinks(t, list, 3, 0, 1, 2, 4)
+	list.MoveToBack(nodes[0])
+	checkLinks(t, list, 3, 1, 2, 4, 0)
+	list.MoveBefore(nodes[4], nodes[3])
+	checkLinks(t, list, 4, 3, 1, 2, 0)
+	list.MoveAfter(nodes[3], nodes[0])
+	checkLinks(t, list, 4, 1, 2, 0, 3)
+	list.MoveAfter(nodes[1], nodes[2])
+	checkLinks(t, list, 4, 2, 1, 0, 3)
+
+	// Moves involving the node itself, its current place or a foreign node change nothing
+	list.MoveToFront(nodes[4])
+	list.MoveToBack(nodes[3])
+	list.MoveBefore(nodes[1], nodes[1])
+	list.MoveBefore(nodes[2], nodes[1])
+	list.MoveAfter(nodes[0], New().AddLast(9))
+	list.MoveToFront(nil)
+	checkLinks(t, list, 4, 2, 1, 0, 3)
+
+	// Removed nodes cannot be moved back in
+	list.RemoveFirst()
+	list.MoveToFront(nodes[4])
+	checkLinks(t, list, 2, 1, 0, 3)
+}
+
+func TestList_InsertAt(t *testing.T) {
+	list := New()
+	if err := list.InsertAt(0, 1, 4); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if err := list.InsertAt(1, 2, 3); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if err := list.InsertAt(4, 5); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if err := list.InsertAt(0, 0); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	checkLinks(t, list, 0, 1, 2, 3, 4, 5)
+
+	for _, index := range []int{-1, 7} {
+		if err := list.InsertAt(index, 9); err != ErrIndexOutOfRange {
+			t.Errorf("InsertAt(%d): expected ErrIndexOutOfRange, got %v", index, err)
+		}
+	}
+	checkLinks(t, list, 0, 1, 2, 3, 4, 5)
+}
+
+func TestList_RemoveAtSet(t *testing.T) {
+	list := New()
+	_ = list.InsertAt(0, "a", "b", "c", "d", "e")
+
+	// Indexes near both ends are reached from the nearest one
+	if value, err := list.RemoveAt(3); err != nil || value != "d" {
+		t.Errorf("RemoveAt(3): expected d, got %v, %v", value, err)
+	}
+	if value, err := list.RemoveAt(0); err != nil || value != "a" {
+		t.Errorf("RemoveAt(0): expected a, got %v, %v", value, err)
+	}
+	if _, err := list.RemoveAt(3); err != ErrIndexOutOfRange {
+		t.Errorf("Expected ErrIndexOutOfRange, got %v", err)
+	}
+	checkLinks(t, list, "b", "c", "e")
+
+	if err := list.Set(2, "E"); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if err := list.Set(-1, "x"); err != ErrIndexOutOfRange {
+		t.Errorf("Expected ErrIndexOutOfRange, got %v", err)
+	}
+	checkLinks(t, list, "b", "c", "E")
+}
+
+func TestList_RemoveValue(t *testing.T) {
+	list := New()
+	_ = list.InsertAt(0, 1, 2, 3, 2, 4, 2)
+
+	if !list.RemoveValue(2) || list.RemoveValue(9) {
+		t.Errorf("Unexpected RemoveValue results")
+	}
+	checkLinks(t, list, 1, 3, 2, 4, 2)
+	if !list.Contains(2) || list.Contains(9) {
+		t.Errorf("Unexpected Contains results")
+	}
+	if index := list.LastIndexOf(2); index != 4 {
+		t.Errorf("Expected last index 4, got %d", index)
+	}
+	if index := list.LastIndexOf(9); index != -1 {
+		t.Errorf("Expected -1, got %d", index)
+	}
+
+	if n := list.RemoveAll(func(value interface{}) bool { return value.(int)%2 == 0 }); n != 3 {
+		t.Errorf("Expected 3 removed elements, got %d", n)
+	}
+	checkLinks(t, list, 1, 3)
+	if n := list.RemoveAll(func(interface{}) bool { return true }); n != 2 {
+		t.Errorf("Expected 2 removed elements, got %d", n)
+	}
+	checkLinks(t, list)
+}
+
+func TestList_SubList(t *testing.T) {
+	list := New()
+	_ = list.InsertAt(0, "a", "b", "c", "d")
+
+	sub, err := list.SubList(1, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	checkLinks(t, sub, "b", "c")
+
+	// The sublist is a copy
+	sub.RemoveFirst()
+	checkLinks(t, list, "a", "b", "c", "d")
+
+	if sub, err := list.SubList(4, 4); err != nil || !sub.IsEmpty() {
+		t.Errorf("Expected an empty sublist, got %v, %v", sub, err)
+	}
+	for _, r := range [][2]int{{-1, 2}, {2, 5}, {3, 2}} {
+		if _, err := list.SubList(r[0], r[1]); err != ErrIndexOutOfRange {
+			t.Errorf("SubList(%d, %d): expected ErrIndexOutOfRange, got %v", r[0], r[1], err)
+		}
+	}
+}
@@ -11,11 +11,11 @@ type DoublyLinkedList interface {
 	Iterate() []interface{} // Returns a slice of all elements in the list.
 	Values() []interface{}  // Alias for Iterate, returns a slice of all elements in the list.
 
-	Add(value interface{})      // Adds an element to the list (implementation will vary).
-	AddFirst(value interface{}) // Adds an element at the beginning of the list.
-	Append(value interface{})   // Adds an element at the end (alias for AddLast).
-	AddLast(value interface{})  // Adds an element at the end of the list.
-	Prepend(value interface{})  // Adds an element at the beginning (alias for AddFirst).
+	Add(value interface{})            // Adds an element to the list (implementation will vary).
+	AddFirst(value interface{}) *Node // Adds an element at the beginning of the list and returns its node.
+	Append(value interface{})         // Adds an element at the end (alias for AddLast).
+	AddLast(value interface{}) *Node  // Adds an element at the end of the list and returns its node.
+	Prepend(value interface{})        // Adds an element at the beginning (alias for AddFirst).
 
 	RemoveFirst() interface{} // Removes and returns the first element of the list.
 	RemoveLast() interface{}  // Removes and returns the last element of the list.
@@ -31,5 +31,40 @@ type DoublyLinkedList interface {
 	// Returns -1 if the value is not found.
 	IndexOf(value interface{}) int
 
+	// LastIndexOf returns the index of the last occurrence of the given value.
+	// Returns -1 if the value is not found.
+	LastIndexOf(value interface{}) int
+
+	Contains(value interface{}) bool                 // Checks if the list holds the given value.
+	InsertAt(index int, values ...interface{}) error // Inserts values at the specified index, Size appends them.
+	RemoveAt(index int) (interface{}, error)         // Removes and returns the element at the specified index.
+	Set(index int, value interface{}) error          // Replaces the element at the specified index.
+	RemoveValue(value interface{}) bool              // Removes the first occurrence of the given value.
+	RemoveAll(pred func(value interface{}) bool) int // Removes every element matching pred, returns how many were removed.
+	SubList(from, to int) (*List, error)             // Returns a new list with the elements in [from, to).
+
 	String() string // Returns a string representation of the list.
+
+	Front() *Node                                     // Returns the first node of the list.
+	Back() *Node                                      // Returns the last node of the list.
+	InsertBefore(value interface{}, mark *Node) *Node // Inserts an element right before mark in O(1).
+	InsertAfter(value interface{}, mark *Node) *Node  // Inserts an element right after mark in O(1).
+	RemoveNode(node *Node) interface{}                // Removes a node in O(1) and returns its value.
+	MoveToFront(node *Node)                           // Moves a node to the beginning of the list.
+	MoveToBack(node *Node)                            // Moves a node to the end of the list.
+	MoveBefore(node, mark *Node)                      // Moves a node right before mark.
+	MoveAfter(node, mark *Node)                       // Moves a node right after mark.
+
+	Concat(other *List)                    // Moves all elements of other to the end of the list in O(1).
+	SpliceAt(index int, other *List) error // Moves all elements of other into the list at the specified index.
+	SplitAt(index int) (*List, error)      // Cuts the list, returning a new list with the elements from index on.
+	Reverse()                              // Reverses the order of the elements in place.
+	Rotate(k int)                          // Moves the last k elements to the front, negative k rotates left.
+
+	// Sort sorts the list in place with a stable merge sort, relinking the nodes.
+	// A nil less orders the values with utils.CompareObjects, here and in the methods below.
+	Sort(less func(a, b interface{}) bool)
+	IsSorted(less func(a, b interface{}) bool) bool                         // Checks if the list is sorted according to less.
+	InsertSorted(value interface{}, less func(a, b interface{}) bool) *Node // Inserts a value into a sorted list and returns its node.
+	MergeSorted(other *List, less func(a, b interface{}) bool)              // Moves all elements of the sorted list other into the sorted list.
 }
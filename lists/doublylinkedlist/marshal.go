@@ -0,0 +1,133 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package doublylinkedlist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON encodes the list as a JSON array ordered from head to tail
+func (l *List) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.valuesOrEmpty())
+}
+
+// UnmarshalJSON decodes a JSON array, replacing the content of the list
+// Elements are decoded with the default encoding/json types, so numbers become float64.
+func (l *List) UnmarshalJSON(data []byte) error {
+	var values []interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	l.replace(values)
+	return nil
+}
+
+// MarshalText encodes the list as its JSON representation
+func (l *List) MarshalText() ([]byte, error) {
+	return l.MarshalJSON()
+}
+
+// UnmarshalText decodes text produced by MarshalText, replacing the content of the list
+func (l *List) UnmarshalText(text []byte) error {
+	return l.UnmarshalJSON(text)
+}
+
+// GobEncode encodes the elements of the list with encoding/gob
+// Concrete element types other than the gob built-ins must be registered with gob.Register.
+func (l *List) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(l.valuesOrEmpty()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes data produced by GobEncode, replacing the content of the list
+func (l *List) GobDecode(data []byte) error {
+	var values []interface{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	l.replace(values)
+	return nil
+}
+
+// valuesOrEmpty returns the values of the list, using an empty slice rather than nil
+// so that an empty list encodes as [] instead of null
+func (l *List) valuesOrEmpty() []interface{} {
+	values := make([]interface{}, 0, l.size)
+	return append(values, l.Iterate()...)
+}
+
+// replace drops all elements of the list and appends values in order
+func (l *List) replace(values []interface{}) {
+	// Dropping the owner detaches the old nodes, they are not handles of the list anymore
+	l.head, l.tail, l.size, l.owner = nil, nil, 0, nil
+	for _, value := range values {
+		l.AddLast(value)
+	}
+}
+
+// MarshalJSON encodes the list as a JSON array ordered from head to tail
+func (l *GenericList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.valuesOrEmpty())
+}
+
+// UnmarshalJSON decodes a JSON array, replacing the content of the list
+func (l *GenericList[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	l.replace(values)
+	return nil
+}
+
+// MarshalText encodes the list as its JSON representation
+func (l *GenericList[T]) MarshalText() ([]byte, error) {
+	return l.MarshalJSON()
+}
+
+// UnmarshalText decodes text produced by MarshalText, replacing the content of the list
+func (l *GenericList[T]) UnmarshalText(text []byte) error {
+	return l.UnmarshalJSON(text)
+}
+
+// GobEncode encodes the elements of the list with encoding/gob
+func (l *GenericList[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(l.valuesOrEmpty()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes data produced by GobEncode, replacing the content of the list
+func (l *GenericList[T]) GobDecode(data []byte) error {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	l.replace(values)
+	return nil
+}
+
+// valuesOrEmpty returns the values of the list, using an empty slice rather than nil
+// so that an empty list encodes as [] instead of null
+func (l *GenericList[T]) valuesOrEmpty() []T {
+	values := make([]T, 0, l.size)
+	return append(values, l.Iterate()...)
+}
+
+// replace drops all elements of the list and appends values in order
+func (l *GenericList[T]) replace(values []T) {
+	// Dropping the owner detaches the old nodes, they are not handles of the list anymore
+	l.head, l.tail, l.size, l.owner = nil, nil, 0, nil
+	for _, value := range values {
+		l.AddLast(value)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package doublylinkedlist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestList_MarshalJSON(t *testing.T) {
+	l := New()
+	l.AddLast("a")
+	l.AddLast("b")
+	l.AddLast("c")
+
+	data, err := json.Marshal(l)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != `["a","b","c"]` {
+		t.Errorf(`Expected ["a","b","c"], got %s`, data)
+	}
+
+	decoded := New()
+	decoded.AddLast("stale")
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.Values(), l.Values()) || decoded.PeekLast() != "c" {
+		t.Errorf("Expected %v after round trip, got %v", l, decoded)
+	}
+
+	if data, _ := json.Marshal(New()); string(data) != "[]" {
+		t.Errorf("Expected empty list to encode as [], got %s", data)
+	}
+}
+
+func TestList_MarshalText(t *testing.T) {
+	l := New()
+	l.AddLast("x")
+	text, err := l.MarshalText()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	decoded := New()
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Size() != 1 || decoded.PeekFirst() != "x" {
+		t.Errorf("Expected %v after round trip, got %v", l, decoded)
+	}
+}
+
+func TestList_GobEncode(t *testing.T) {
+	l := New()
+	l.AddLast(1)
+	l.AddLast("two")
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(l); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := New()
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.Values(), l.Values()) {
+		t.Errorf("Expected %v after round trip, got %v", l, decoded)
+	}
+}
+
+func TestGenericList_Marshal(t *testing.T) {
+	l := NewGenericList[int]()
+	l.AddLast(1)
+	l.AddLast(2)
+	l.AddLast(3)
+
+	data, err := json.Marshal(l)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromJSON := NewGenericList[int]()
+	if err := json.Unmarshal(data, fromJSON); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fromJSON.Values(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] after JSON round trip, got %v", fromJSON.Values())
+	}
+
+	text, err := l.MarshalText()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromText := NewGenericList[int]()
+	if err := fromText.UnmarshalText(text); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fromText.Values(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] after text round trip, got %v", fromText.Values())
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(l); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromGob := NewGenericList[int]()
+	if err := gob.NewDecoder(&buf).Decode(fromGob); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fromGob.Values(), []int{1, 2, 3}) || fromGob.PeekLast() != 3 {
+		t.Errorf("Expected [1 2 3] after gob round trip, got %v", fromGob.Values())
+	}
+
+	if data, _ := json.Marshal(NewGenericList[int]()); string(data) != "[]" {
+		t.Errorf("Expected empty list to encode as [], got %s", data)
+	}
+}
+
+func TestList_Unmarshal(t *testing.T) {
+	decoded := New()
+	stale := decoded.AddLast("stale")
+	if err := json.Unmarshal([]byte(`["a","b","c"]`), decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	checkLinks(t, decoded, "a", "b", "c")
+
+	// The nodes of the old content are not handles of the list anymore
+	if decoded.RemoveNode(stale) != nil || decoded.InsertAfter("x", stale) != nil {
+		t.Errorf("Expected the old nodes to be rejected after decoding")
+	}
+}
+
+func TestGenericList_GobEncode(t *testing.T) {
+	l := NewGenericList[int]()
+	l.AddLast(1)
+	l.AddLast(2)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(l); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := NewGenericList[int]()
+	decoded.AddLast(9)
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	checkGenericLinks(t, decoded, 1, 2)
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// Reference: https://www.chiark.greenend.org.uk/~sgtatham/algorithms/listsort.html
+
+package doublylinkedlist
+
+import "github.com/ethan-gao-code/go-ds/utils"
+
+// Sort sorts the list in place with a stable bottom-up merge sort, in O(n log n) time and O(1) extra space.
+// The nodes are relinked rather than copied, so they keep working as handles.
+// A nil less orders the values with utils.CompareObjects.
+func (l *List) Sort(less func(a, b interface{}) bool) {
+	if l.size < 2 {
+		return
+	}
+	less = orCompareObjects(less)
+
+	head := l.head
+	for width := 1; width < l.size; width *= 2 {
+		// Merge the runs of width nodes two by two, following the next pointers only
+		var merged, tail *Node
+		for rest := head; rest != nil; {
+			left := rest
+			right := cutNodes(left, width)
+			rest = cutNodes(right, width)
+			first, last := mergeNodes(left, right, less)
+			if tail == nil {
+				merged = first
+			} else {
+				tail.next = first
+			}
+			tail = last
+		}
+		head = merged
+	}
+	l.relink(head)
+}
+
+// IsSorted checks if the list is sorted according to less.
+// A nil less orders the values with utils.CompareObjects.
+func (l *List) IsSorted(less func(a, b interface{}) bool) bool {
+	less = orCompareObjects(less)
+	for current := l.head; current != nil && current.next != nil; current = current.next {
+		if less(current.next.value, current.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// InsertSorted inserts a value into a sorted list, after the elements equal to it, and returns its node.
+// The position is searched from the end of the list, so adding values in almost increasing order is fast.
+// A nil less orders the values with utils.CompareObjects.
+func (l *List) InsertSorted(value interface{}, less func(a, b interface{}) bool) *Node {
+	less = orCompareObjects(less)
+	prev := l.tail
+	for prev != nil && less(value, prev.value) {
+		prev = prev.prev
+	}
+	if prev == nil {
+		return l.link(&Node{value: value}, nil, l.head)
+	}
+	return l.link(&Node{value: value}, prev, prev.next)
+}
+
+// MergeSorted moves all the elements of the sorted list other into the sorted list in O(n+m), leaving other empty.
+// The merge is stable, the elements of the list come before the equal elements of other.
+// The nodes of other keep working as handles, now of the list. MergeSorted with the list itself does nothing.
+// A nil less orders the values with utils.CompareObjects.
+func (l *List) MergeSorted(other *List, less func(a, b interface{}) bool) {
+	if other == nil || other == l || other.size == 0 {
+		return
+	}
+	head, _ := mergeNodes(l.head, other.head, orCompareObjects(less))
+	l.size += other.size
+	l.relink(head)
+
+	// Forward the owner of other, so that its nodes belong to the list without visiting them
+	other.owner.parent = l.nodeOwner()
+	other.head, other.tail, other.size, other.owner = nil, nil, 0, nil
+}
+
+// relink restores the prev pointers and the tail of the list from the next pointers starting at head.
+func (l *List) relink(head *Node) {
+	var prev *Node
+	for current := head; current != nil; current = current.next {
+		current.prev, prev = prev, current
+	}
+	l.head, l.tail = head, prev
+}
+
+// cutNodes cuts the chain of nodes after n nodes and returns the rest of the chain.
+func cutNodes(node *Node, n int) *Node {
+	for ; node != nil && n > 1; n-- {
+		node = node.next
+	}
+	if node == nil {
+		return nil
+	}
+	rest := node.next
+	node.next = nil
+	return rest
+}
+
+// mergeNodes merges two sorted chains of nodes and returns the first and last nodes of the result.
+// Nodes of a come first among equal values, which keeps the sort stable.
+func mergeNodes(a, b *Node, less func(a, b interface{}) bool) (*Node, *Node) {
+	var head, tail *Node
+	next := &head
+	for a != nil && b != nil {
+		if less(b.value, a.value) {
+			tail, b = b, b.next
+		} else {
+			tail, a = a, a.next
+		}
+		*next = tail
+		next = &tail.next
+	}
+	if a == nil {
+		a = b
+	}
+	for *next = a; a != nil; a = a.next {
+		tail = a
+	}
+	return head, tail
+}
+
+// orCompareObjects returns less, or a function ordering values with utils.CompareObjects if less is nil.
+func orCompareObjects(less func(a, b interface{}) bool) func(a, b interface{}) bool {
+	if less != nil {
+		return less
+	}
+	return func(a, b interface{}) bool {
+		return utils.CompareObjects(a, b) < 0
+	}
+}
+
+// Sort sorts the list in place with a stable bottom-up merge sort, in O(n log n) time and O(1) extra space.
+// The nodes are relinked rather than copied, so they keep working as handles.
+func (l *GenericList[T]) Sort(less func(a, b T) bool) {
+	if l.size < 2 {
+		return
+	}
+
+	head := l.head
+	for width := 1; width < l.size; width *= 2 {
+		// Merge the runs of width nodes two by two, following the next pointers only
+		var merged, tail *GenericNode[T]
+		for rest := head; rest != nil; {
+			left := rest
+			right := cutGenericNodes(left, width)
+			rest = cutGenericNodes(right, width)
+			first, last := mergeGenericNodes(left, right, less)
+			if tail == nil {
+				merged = first
+			} else {
+				tail.next = first
+			}
+			tail = last
+		}
+		head = merged
+	}
+	l.relink(head)
+}
+
+// IsSorted checks if the list is sorted according to less.
+func (l *GenericList[T]) IsSorted(less func(a, b T) bool) bool {
+	for current := l.head; current != nil && current.next != nil; current = current.next {
+		if less(current.next.value, current.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// InsertSorted inserts a value into a sorted list, after the elements equal to it, and returns its node.
+// The position is searched from the end of the list, so adding values in almost increasing order is fast.
+func (l *GenericList[T]) InsertSorted(value T, less func(a, b T) bool) *GenericNode[T] {
+	prev := l.tail
+	for prev != nil && less(value, prev.value) {
+		prev = prev.prev
+	}
+	if prev == nil {
+		return l.link(&GenericNode[T]{value: value}, nil, l.head)
+	}
+	return l.link(&GenericNode[T]{value: value}, prev, prev.next)
+}
+
+// MergeSorted moves all the elements of the sorted list other into the sorted list in O(n+m), leaving other empty.
+// The merge is stable, the elements of the list come before the equal elements of other.
+// The nodes of other keep working as handles, now of the list. MergeSorted with the list itself does nothing.
+func (l *GenericList[T]) MergeSorted(other *GenericList[T], less func(a, b T) bool) {
+	if other == nil || other == l || other.size == 0 {
+		return
+	}
+	head, _ := mergeGenericNodes(l.head, other.head, less)
+	l.size += other.size
+	l.relink(head)
+
+	// Forward the owner of other, so that its nodes belong to the list without visiting them
+	other.owner.parent = l.nodeOwner()
+	other.head, other.tail, other.size, other.owner = nil, nil, 0, nil
+}
+
+// relink restores the prev pointers and the tail of the list from the next pointers starting at head.
+func (l *GenericList[T]) relink(head *GenericNode[T]) {
+	var prev *GenericNode[T]
+	for current := head; current != nil; current = current.next {
+		current.prev, prev = prev, current
+	}
+	l.head, l.tail = head, prev
+}
+
+// cutGenericNodes cuts the chain of nodes after n nodes and returns the rest of the chain.
+func cutGenericNodes[T comparable](node *GenericNode[T], n int) *GenericNode[T] {
+	for ; node != nil && n > 1; n-- {
+		node = node.next
+	}
+	if node == nil {
+		return nil
+	}
+	rest := node.next
+	node.next = nil
+	return rest
+}
+
+// mergeGenericNodes merges two sorted chains of nodes and returns the first and last nodes of the result.
+// Nodes of a come first among equal values, which keeps the sort stable.
+func mergeGenericNodes[T comparable](a, b *GenericNode[T], less func(a, b T) bool) (*GenericNode[T], *GenericNode[T]) {
+	var head, tail *GenericNode[T]
+	next := &head
+	for a != nil && b != nil {
+		if less(b.value, a.value) {
+			tail, b = b, b.next
+		} else {
+			tail, a = a, a.next
+		}
+		*next = tail
+		next = &tail.next
+	}
+	if a == nil {
+		a = b
+	}
+	for *next = a; a != nil; a = a.next {
+		tail = a
+	}
+	return head, tail
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package doublylinkedlist
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// record is a value with a sort key and its insertion order, to check that sorting is stable
+type record struct {
+	key, seq int
+}
+
+func lessRecords(a, b interface{}) bool {
+	return a.(record).key < b.(record).key
+}
+
+func TestList_Sort(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, size := range []int{0, 1, 2, 3, 7, 8, 9, 100, 1000} {
+		list := New()
+		expected := make([]interface{}, 0, size)
+		nodes := make([]*Node, 0, size)
+		for i := 0; i < size; i++ {
+			value := record{key: r.Intn(size/4 + 1), seq: i}
+			nodes = append(nodes, list.AddLast(value))
+			expected = append(expected, value)
+		}
+		sort.SliceStable(expected, func(i, j int) bool { return lessRecords(expected[i], expected[j]) })
+
+		list.Sort(lessRecords)
+		checkLinks(t, list, expected...)
+		if !list.IsSorted(lessRecords) {
+			t.Fatalf("Expected the list of size %d to be sorted", size)
+		}
+		// The nodes were relinked, not copied
+		for _, node := range nodes {
+			if !list.owns(node) {
+				t.Fatalf("Expected the nodes to stay in the list after sorting")
+			}
+		}
+	}
+}
+
+func TestList_SortDefault(t *testing.T) {
+	list := New()
+	for _, value := range []interface{}{"pear", "apple", "fig", "banana"} {
+		list.AddLast(value)
+	}
+	if list.IsSorted(nil) {
+		t.Errorf("Expected the list not to be sorted")
+	}
+	list.Sort(nil)
+	checkLinks(t, list, "apple", "banana", "fig", "pear")
+
+	numbers := New()
+	for _, value := range []interface{}{3, -1, 10, 2} {
+		numbers.AddLast(value)
+	}
+	numbers.Sort(nil)
+	checkLinks(t, numbers, -1, 2, 3, 10)
+	numbers.Sort(func(a, b interface{}) bool { return a.(int) > b.(int) })
+	checkLinks(t, numbers, 10, 3, 2, -1)
+}
+
+func TestList_InsertSorted(t *testing.T) {
+	list := New()
+	for i, key := range []int{5, 1, 3, 3, 9, 0, 5} {
+		list.InsertSorted(record{key: key, seq: i}, lessRecords)
+	}
+	// Equal keys keep their insertion order
+	checkLinks(t, list, record{0, 5}, record{1, 1}, record{3, 2}, record{3, 3}, record{5, 0}, record{5, 6}, record{9, 4})
+
+	numbers := New()
+	numbers.InsertSorted(2, nil)
+	numbers.InsertSorted(1, nil)
+	node := numbers.InsertSorted(3, nil)
+	checkLinks(t, numbers, 1, 2, 3)
+	if numbers.RemoveNode(node) != 3 {
+		t.Errorf("Expected InsertSorted to return the node of the value")
+	}
+}
+
+func TestList_MergeSorted(t *testing.T) {
+	list := New()
+	other := New()
+	for i, key := range []int{1, 3, 3, 7} {
+		list.AddLast(record{key: key, seq: i})
+	}
+	var otherNodes []*Node
+	for i, key := range []int{0, 3, 8, 9} {
+		otherNodes = append(otherNodes, other.AddLast(record{key: key, seq: 10 + i}))
+	}
+
+	list.MergeSorted(other, lessRecords)
+	checkLinks(t, list, record{0, 10}, record{1, 0}, record{3, 1}, record{3, 2}, record{3, 11}, record{7, 3}, record{8, 12}, record{9, 13})
+	checkLinks(t, other)
+	for _, node := range otherNodes {
+		if !list.owns(node) || other.owns(node) {
+			t.Fatalf("Expected the merged nodes to belong to the list")
+		}
+	}
+
+	list.MergeSorted(list, lessRecords)
+	list.MergeSorted(nil, lessRecords)
+	if list.Size() != 8 {
+		t.Errorf("Expected merging the list itself or nil to do nothing, got %v", list)
+	}
+
+	empty := New()
+	numbers := New()
+	numbers.AddLast(1)
+	numbers.AddLast(2)
+	empty.MergeSorted(numbers, nil)
+	checkLinks(t, empty, 1, 2)
+}
+
+func TestGenericList_Sort(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	less := func(a, b record) bool { return a.key < b.key }
+
+	list := NewGenericList[record]()
+	expected := make([]record, 0, 500)
+	for i := 0; i < 500; i++ {
+		value := record{key: r.Intn(50), seq: i}
+		list.AddLast(value)
+		expected = append(expected, value)
+	}
+	sort.SliceStable(expected, func(i, j int) bool { return less(expected[i], expected[j]) })
+
+	if list.IsSorted(less) {
+		t.Errorf("Expected the list not to be sorted")
+	}
+	list.Sort(less)
+	checkGenericLinks(t, list, expected...)
+
+	node := list.InsertSorted(record{key: 25, seq: -1}, less)
+	if node.Prev().Value().key > 25 || node.Next().Value().key <= 25 || !list.IsSorted(less) {
+		t.Errorf("Expected the value to be inserted after the equal keys")
+	}
+
+	other := NewGenericList[record]()
+	other.AddLast(record{key: -1})
+	other.AddLast(record{key: 100})
+	list.MergeSorted(other, less)
+	if list.Size() != 503 || list.PeekFirst().key != -1 || list.PeekLast().key != 100 || !other.IsEmpty() {
+		t.Errorf("Unexpected merge result")
+	}
+}
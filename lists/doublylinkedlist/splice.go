@@ -0,0 +1,269 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package doublylinkedlist
+
+// Concat moves all the elements of other to the end of the list in O(1), leaving other empty.
+// The nodes of other keep working as handles, now of the list. Concat with the list itself does nothing.
+func (l *List) Concat(other *List) {
+	if other == nil || other == l || other.size == 0 {
+		return
+	}
+	l.adopt(other, l.tail, nil)
+}
+
+// SpliceAt moves all the elements of other into the list at the specified index, leaving other empty.
+// The nodes are moved in O(1), only the traversal to the index depends on the size of the list.
+// An index equal to Size appends them. Returns ErrIndexOutOfRange for other indexes,
+// or ErrSameList if other is the list itself.
+func (l *List) SpliceAt(index int, other *List) error {
+	if other == l {
+		return ErrSameList
+	}
+	if index < 0 || index > l.size {
+		return ErrIndexOutOfRange
+	}
+	if other == nil || other.size == 0 {
+		return nil
+	}
+
+	var prev, next *Node
+	if index == l.size {
+		prev = l.tail
+	} else {
+		next = l.nodeAt(index)
+		prev = next.prev
+	}
+	l.adopt(other, prev, next)
+	return nil
+}
+
+// SplitAt cuts the list at the specified index and returns a new list holding the elements from index on.
+// The list keeps the elements before index. An index equal to Size returns an empty list.
+// Returns ErrIndexOutOfRange for other indexes.
+func (l *List) SplitAt(index int) (*List, error) {
+	if index < 0 || index > l.size {
+		return nil, ErrIndexOutOfRange
+	}
+	rest := New()
+	if index == l.size {
+		return rest, nil
+	}
+
+	first := l.nodeAt(index)
+	rest.head, rest.tail, rest.size = first, l.tail, l.size-index
+	if first.prev != nil {
+		l.tail = first.prev
+		l.tail.next = nil
+	} else {
+		l.head, l.tail = nil, nil
+	}
+	first.prev = nil
+	l.size = index
+
+	// The larger part keeps the current owner, only the nodes of the smaller part are updated
+	if rest.size <= l.size {
+		rest.retag()
+	} else {
+		rest.owner, l.owner = l.owner, nil
+		l.retag()
+	}
+	return rest, nil
+}
+
+// Reverse reverses the order of the elements in place.
+func (l *List) Reverse() {
+	for current := l.head; current != nil; current = current.prev {
+		current.next, current.prev = current.prev, current.next
+	}
+	l.head, l.tail = l.tail, l.head
+}
+
+// Rotate rotates the list by k positions to the right, moving the last k elements to the front.
+// A negative k rotates to the left, and k may exceed the size of the list.
+func (l *List) Rotate(k int) {
+	if l.size < 2 {
+		return
+	}
+	k %= l.size
+	if k < 0 {
+		k += l.size
+	}
+	if k == 0 {
+		return
+	}
+
+	newHead := l.nodeAt(l.size - k)
+	// Close the ring, then open it before the new head
+	l.tail.next, l.head.prev = l.head, l.tail
+	l.head, l.tail = newHead, newHead.prev
+	l.head.prev, l.tail.next = nil, nil
+}
+
+// adopt links all the nodes of other between prev and next, and empties other.
+func (l *List) adopt(other *List, prev, next *Node) {
+	first, last := other.head, other.tail
+	first.prev, last.next = prev, next
+	if prev != nil {
+		prev.next = first
+	} else {
+		l.head = first
+	}
+	if next != nil {
+		next.prev = last
+	} else {
+		l.tail = last
+	}
+	l.size += other.size
+
+	// Forward the owner of other, so that its nodes belong to the list without visiting them
+	other.owner.parent = l.nodeOwner()
+	other.head, other.tail, other.size, other.owner = nil, nil, 0, nil
+}
+
+// retag gives the owner of the list to all its nodes.
+func (l *List) retag() {
+	o := l.nodeOwner()
+	for current := l.head; current != nil; current = current.next {
+		current.owner = o
+	}
+}
+
+// Concat moves all the elements of other to the end of the list in O(1), leaving other empty.
+// The nodes of other keep working as handles, now of the list. Concat with the list itself does nothing.
+func (l *GenericList[T]) Concat(other *GenericList[T]) {
+	if other == nil || other == l || other.size == 0 {
+		return
+	}
+	l.adopt(other, l.tail, nil)
+}
+
+// SpliceAt moves all the elements of other into the list at the specified index, leaving other empty.
+// The nodes are moved in O(1), only the traversal to the index depends on the size of the list.
+// An index equal to Size appends them. Returns ErrIndexOutOfRange for other indexes,
+// or ErrSameList if other is the list itself.
+func (l *GenericList[T]) SpliceAt(index int, other *GenericList[T]) error {
+	if other == l {
+		return ErrSameList
+	}
+	if index < 0 || index > l.size {
+		return ErrIndexOutOfRange
+	}
+	if other == nil || other.size == 0 {
+		return nil
+	}
+
+	var prev, next *GenericNode[T]
+	if index == l.size {
+		prev = l.tail
+	} else {
+		next = l.nodeAt(index)
+		prev = next.prev
+	}
+	l.adopt(other, prev, next)
+	return nil
+}
+
+// SplitAt cuts the list at the specified index and returns a new list holding the elements from index on.
+// The list keeps the elements before index. An index equal to Size returns an empty list.
+// Returns ErrIndexOutOfRange for other indexes.
+func (l *GenericList[T]) SplitAt(index int) (*GenericList[T], error) {
+	if index < 0 || index > l.size {
+		return nil, ErrIndexOutOfRange
+	}
+	rest := NewGenericList[T]()
+	if index == l.size {
+		return rest, nil
+	}
+
+	first := l.nodeAt(index)
+	rest.head, rest.tail, rest.size = first, l.tail, l.size-index
+	if first.prev != nil {
+		l.tail = first.prev
+		l.tail.next = nil
+	} else {
+		l.head, l.tail = nil, nil
+	}
+	first.prev = nil
+	l.size = index
+
+	// The larger part keeps the current owner, only the nodes of the smaller part are updated
+	if rest.size <= l.size {
+		rest.retag()
+	} else {
+		rest.owner, l.owner = l.owner, nil
+		l.retag()
+	}
+	return rest, nil
+}
+
+// Reverse reverses the order of the elements in place.
+func (l *GenericList[T]) Reverse() {
+	for current := l.head; current != nil; current = current.prev {
+		current.next, current.prev = current.prev, current.next
+	}
+	l.head, l.tail = l.tail, l.head
+}
+
+// Rotate rotates the list by k positions to the right, moving the last k elements to the front.
+// A negative k rotates to the left, and k may exceed the size of the list.
+func (l *GenericList[T]) Rotate(k int) {
+	if l.size < 2 {
+		return
+	}
+	k %= l.size
+	if k < 0 {
+		k += l.size
+	}
+	if k == 0 {
+		return
+	}
+
+	newHead := l.nodeAt(l.size - k)
+	// Close the ring, then open it before the new head
+	l.tail.next, l.head.prev = l.head, l.tail
+	l.head, l.tail = newHead, newHead.prev
+	l.head.prev, l.tail.next = nil, nil
+}
+
+// adopt links all the nodes of other between prev and next, and empties other.
+func (l *GenericList[T]) adopt(other *GenericList[T], prev, next *GenericNode[T]) {
+	first, last := other.head, other.tail
+	first.prev, last.next = prev, next
+	if prev != nil {
+		prev.next = first
+	} else {
+		l.head = first
+	}
+	if next != nil {
+		next.prev = last
+	} else {
+		l.tail = last
+	}
+	l.size += other.size
+
+	// Forward the owner of other, so that its nodes belong to the list without visiting them
+	other.owner.parent = l.nodeOwner()
+	other.head, other.tail, other.size, other.owner = nil, nil, 0, nil
+}
+
+// retag gives the owner of the list to all its nodes.
+func (l *GenericList[T]) retag() {
+	o := l.nodeOwner()
+	for current := l.head; current != nil; current = current.next {
+		current.owner = o
+	}
+}
+
+// root follows the forwarding chain to the owner of a list
+// The chain is shortened on the way, so that later lookups are faster.
+func (o *owner) root() *owner {
+	for o.parent != nil {
+		if o.parent.parent != nil {
+			o.parent = o.parent.parent
+		}
+		o = o.parent
+	}
+	return o
+}
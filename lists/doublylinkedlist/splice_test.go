@@ -0,0 +1,218 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package doublylinkedlist
+
+import "testing"
+
+// newIntList builds a list holding the given values and returns it with its nodes
+func newIntList(values ...int) (*List, []*Node) {
+	list := New()
+	nodes := make([]*Node, 0, len(values))
+	for _, value := range values {
+		nodes = append(nodes, list.AddLast(value))
+	}
+	return list, nodes
+}
+
+func TestList_Concat(t *testing.T) {
+	list, _ := newIntList(1, 2)
+	other, otherNodes := newIntList(3, 4)
+
+	list.Concat(other)
+	checkLinks(t, list, 1, 2, 3, 4)
+	checkLinks(t, other)
+
+	// The stolen nodes are handles of the receiving list now
+	list.MoveToFront(otherNodes[1])
+	checkLinks(t, list, 4, 1, 2, 3)
+	if other.RemoveNode(otherNodes[0]) != nil {
+		t.Errorf("Expected the emptied list to reject its former nodes")
+	}
+
+	// The emptied list can be reused, and its new nodes are not mixed up with the old ones
+	node := other.AddLast(5)
+	if list.RemoveNode(node) != nil || !other.owns(node) {
+		t.Errorf("Expected the new node to belong to the emptied list only")
+	}
+
+	list.Concat(list)
+	list.Concat(New())
+	list.Concat(nil)
+	checkLinks(t, list, 4, 1, 2, 3)
+
+	empty := New()
+	empty.Concat(other)
+	checkLinks(t, empty, 5)
+}
+
+func TestList_ConcatChain(t *testing.T) {
+	// Nodes moved through several lists still find their current owner
+	a, aNodes := newIntList(1)
+	b, bNodes := newIntList(2)
+	c, _ := newIntList(3)
+	b.Concat(a)
+	c.Concat(b)
+	checkLinks(t, c, 3, 2, 1)
+
+	c.MoveToFront(aNodes[0])
+	c.MoveToBack(bNodes[0])
+	checkLinks(t, c, 1, 3, 2)
+	if a.owns(aNodes[0]) || b.owns(bNodes[0]) {
+		t.Errorf("Expected the emptied lists to own nothing")
+	}
+}
+
+func TestList_SpliceAt(t *testing.T) {
+	list, _ := newIntList(1, 4)
+	other, otherNodes := newIntList(2, 3)
+
+	if err := list.SpliceAt(1, other); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	checkLinks(t, list, 1, 2, 3, 4)
+	checkLinks(t, other)
+	if list.InsertAfter(0, otherNodes[1]) == nil {
+		t.Errorf("Expected the spliced nodes to be usable as handles")
+	}
+	checkLinks(t, list, 1, 2, 3, 0, 4)
+
+	front, _ := newIntList(-1)
+	back, _ := newIntList(9)
+	_ = list.SpliceAt(0, front)
+	_ = list.SpliceAt(list.Size(), back)
+	checkLinks(t, list, -1, 1, 2, 3, 0, 4, 9)
+
+	if err := list.SpliceAt(8, New()); err != ErrIndexOutOfRange {
+		t.Errorf("Expected ErrIndexOutOfRange, got %v", err)
+	}
+	if err := list.SpliceAt(0, list); err != ErrSameList {
+		t.Errorf("Expected ErrSameList, got %v", err)
+	}
+}
+
+func TestList_SplitAt(t *testing.T) {
+	for index := 0; index <= 6; index++ {
+		list, nodes := newIntList(0, 1, 2, 3, 4, 5)
+		rest, err := list.SplitAt(index)
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		expectedHead := []interface{}{}
+		expectedRest := []interface{}{}
+		for i := 0; i < 6; i++ {
+			if i < index {
+				expectedHead = append(expectedHead, i)
+			} else {
+				expectedRest = append(expectedRest, i)
+			}
+		}
+		checkLinks(t, list, expectedHead...)
+		checkLinks(t, rest, expectedRest...)
+
+		// Every node moved along with its part, whichever part kept the owner
+		for i, node := range nodes {
+			if list.owns(node) != (i < index) || rest.owns(node) != (i >= index) {
+				t.Fatalf("SplitAt(%d): node %d belongs to the wrong list", index, i)
+			}
+		}
+	}
+
+	list, _ := newIntList(1, 2)
+	if _, err := list.SplitAt(3); err != ErrIndexOutOfRange {
+		t.Errorf("Expected ErrIndexOutOfRange, got %v", err)
+	}
+}
+
+func TestList_SplitAfterConcat(t *testing.T) {
+	// Nodes forwarded by Concat are split correctly on both sides
+	list, nodes := newIntList(0, 1, 2)
+	other, otherNodes := newIntList(3, 4, 5, 6)
+	list.Concat(other)
+	nodes = append(nodes, otherNodes...)
+
+	for _, index := range []int{5, 1} {
+		rest, _ := list.SplitAt(index)
+		for i, node := range nodes {
+			if i < index && !list.owns(node) || i >= index && !rest.owns(node) {
+				t.Fatalf("SplitAt(%d): node %d belongs to the wrong list", index, i)
+			}
+		}
+		list.Concat(rest)
+		checkLinks(t, list, 0, 1, 2, 3, 4, 5, 6)
+	}
+}
+
+func TestList_Reverse(t *testing.T) {
+	list, nodes := newIntList(1, 2, 3, 4)
+	list.Reverse()
+	checkLinks(t, list, 4, 3, 2, 1)
+	list.MoveToBack(nodes[3])
+	checkLinks(t, list, 3, 2, 1, 4)
+
+	single, _ := newIntList(1)
+	single.Reverse()
+	checkLinks(t, single, 1)
+	empty := New()
+	empty.Reverse()
+	checkLinks(t, empty)
+}
+
+func TestList_Rotate(t *testing.T) {
+	tests := []struct {
+		k        int
+		expected []interface{}
+	}{
+		{0, []interface{}{1, 2, 3, 4, 5}},
+		{1, []interface{}{5, 1, 2, 3, 4}},
+		{2, []interface{}{4, 5, 1, 2, 3}},
+		{-1, []interface{}{2, 3, 4, 5, 1}},
+		{5, []interface{}{1, 2, 3, 4, 5}},
+		{12, []interface{}{4, 5, 1, 2, 3}},
+		{-7, []interface{}{3, 4, 5, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		list, _ := newIntList(1, 2, 3, 4, 5)
+		list.Rotate(tt.k)
+		checkLinks(t, list, tt.expected...)
+	}
+
+	single, _ := newIntList(1)
+	single.Rotate(3)
+	checkLinks(t, single, 1)
+}
+
+func TestGenericList_Splice(t *testing.T) {
+	list := NewGenericList[string]()
+	_ = list.InsertAt(0, "a", "e")
+	other := NewGenericList[string]()
+	c := other.AddLast("c")
+	_ = other.InsertAt(0, "b")
+	other.AddLast("d")
+
+	if err := list.SpliceAt(1, other); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	checkGenericLinks(t, list, "a", "b", "c", "d", "e")
+	if list.RemoveNode(c) != "c" || other.Size() != 0 {
+		t.Errorf("Expected the spliced node to belong to the list")
+	}
+
+	rest, err := list.SplitAt(1)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	checkGenericLinks(t, list, "a")
+	checkGenericLinks(t, rest, "b", "d", "e")
+
+	rest.Reverse()
+	rest.Rotate(-1)
+	list.Concat(rest)
+	checkGenericLinks(t, list, "a", "d", "b", "e")
+	if err := list.SpliceAt(0, list); err != ErrSameList {
+		t.Errorf("Expected ErrSameList, got %v", err)
+	}
+}
@@ -9,13 +9,15 @@ type Node struct {
 	value interface{} // Value of the node
 	next  *Node       // Pointer to the next node
 	prev  *Node       // Pointer to the previous node
+	owner *owner      // Identifies the list the node belongs to, nil once removed
 }
 
 // List is the implementation of the DoublyLinkedList interface.
 type List struct {
-	head *Node // Head of the list
-	tail *Node // Tail of the list
-	size int   // Size of the list
+	head  *Node  // Head of the list
+	tail  *Node  // Tail of the list
+	size  int    // Size of the list
+	owner *owner // Owner of the nodes of the list, created on the first insertion
 }
 
 // GenericNode defines the structure for a generic doubly linked list node.
@@ -23,11 +25,20 @@ type GenericNode[T comparable] struct {
 	value T               // Value of the node
 	next  *GenericNode[T] // Pointer to the next node
 	prev  *GenericNode[T] // Pointer to the previous node
+	owner *owner          // Identifies the list the node belongs to, nil once removed
 }
 
 // GenericList is the generic implementation of the DoublyLinkedList interface.
 type GenericList[T comparable] struct {
-	head *GenericNode[T] // Head of the list
-	tail *GenericNode[T] // Tail of the list
-	size int             // Size of the list
+	head  *GenericNode[T] // Head of the list
+	tail  *GenericNode[T] // Tail of the list
+	size  int             // Size of the list
+	owner *owner          // Owner of the nodes of the list, created on the first insertion
+}
+
+// owner identifies the list nodes belong to, so that node operations can reject foreign nodes.
+// When a list takes over the nodes of another one, the owner of the other list is forwarded to its own,
+// which moves all the nodes in O(1). Nodes find their current owner by following the forwarding chain.
+type owner struct {
+	parent *owner // Owner this one was forwarded to, nil for the owner of a list
 }
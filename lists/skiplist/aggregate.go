@@ -0,0 +1,209 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// Reference: https://redis.io/docs/latest/commands/zunionstore/
+
+package skiplist
+
+import (
+	"math"
+	"slices"
+)
+
+// aggregated holds the combined score of a member while merging lists
+type aggregated struct {
+	score float64 // Combined weighted score
+	seen  int     // Number of lists the member was found in
+	last  int     // Index of the last list the member was found in
+}
+
+// UnionStore merges lists into a new list holding every member found in at least one of them
+// Each score is multiplied by the weight of its list before the scores of a member are combined with aggregate.
+// nil weights stand for a weight of 1 for every list. Like in Redis, a NaN produced by a weight
+// or by a sum of infinities counts as 0. Members are used as map keys, so they must be comparable,
+// otherwise ErrUnhashableMember is returned, and a member found several times in the same list
+// is combined as if it came from different lists.
+func UnionStore(lists []*List, weights []float64, aggregate Aggregate) (*List, error) {
+	acc, order, err := aggregateLists(lists, weights, aggregate)
+	if err != nil {
+		return nil, err
+	}
+
+	elements := make([]Element, 0, len(order))
+	for _, member := range order {
+		elements = append(elements, Element{Score: acc[member].score, Member: member})
+	}
+	return loadSorted(elements)
+}
+
+// InterStore merges lists into a new list holding the members found in every one of them
+// weights and aggregate behave as in UnionStore. An empty slice of lists gives an empty list.
+func InterStore(lists []*List, weights []float64, aggregate Aggregate) (*List, error) {
+	acc, order, err := aggregateLists(lists, weights, aggregate)
+	if err != nil {
+		return nil, err
+	}
+
+	var elements []Element
+	for _, member := range order {
+		if a := acc[member]; a.seen == len(lists) {
+			elements = append(elements, Element{Score: a.score, Member: member})
+		}
+	}
+	return loadSorted(elements)
+}
+
+// DiffStore returns a new list holding the members of the first list that are in none of the others
+// Members keep their score from the first list, like Redis ZDIFFSTORE.
+// Members are used as map keys, so they must be comparable, otherwise ErrUnhashableMember is returned.
+func DiffStore(lists []*List) (*List, error) {
+	result := New()
+	if len(lists) == 0 {
+		return result, nil
+	}
+
+	excluded := make(map[interface{}]struct{})
+	for _, sl := range lists[1:] {
+		for x := sl.header.level[0].forward; x != nil; x = x.level[0].forward {
+			if !hashable(x.obj) {
+				return nil, ErrUnhashableMember
+			}
+			excluded[x.obj] = struct{}{}
+		}
+	}
+
+	// The first list is already sorted, so the members it keeps are too
+	var elements []Element
+	for x := lists[0].header.level[0].forward; x != nil; x = x.level[0].forward {
+		if !hashable(x.obj) {
+			return nil, ErrUnhashableMember
+		}
+		if _, found := excluded[x.obj]; !found {
+			elements = append(elements, Element{Score: x.score, Member: x.obj})
+		}
+	}
+	if err := result.BulkLoad(elements); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ZUnionStore merges sorted sets into a new one, see UnionStore
+func ZUnionStore(sets []*SortedSet, weights []float64, aggregate Aggregate) (*SortedSet, error) {
+	result, err := UnionStore(setLists(sets), weights, aggregate)
+	if err != nil {
+		return nil, err
+	}
+	return newSortedSetFrom(result), nil
+}
+
+// ZInterStore intersects sorted sets into a new one, see InterStore
+func ZInterStore(sets []*SortedSet, weights []float64, aggregate Aggregate) (*SortedSet, error) {
+	result, err := InterStore(setLists(sets), weights, aggregate)
+	if err != nil {
+		return nil, err
+	}
+	return newSortedSetFrom(result), nil
+}
+
+// ZDiffStore subtracts the other sorted sets from the first one into a new one, see DiffStore
+func ZDiffStore(sets []*SortedSet) (*SortedSet, error) {
+	result, err := DiffStore(setLists(sets))
+	if err != nil {
+		return nil, err
+	}
+	return newSortedSetFrom(result), nil
+}
+
+// aggregateLists walks every list once and combines the weighted scores of each member
+// It also returns the members in the order they were first found, to build results deterministically.
+func aggregateLists(lists []*List, weights []float64, aggregate Aggregate) (map[interface{}]*aggregated, []interface{}, error) {
+	if weights != nil && len(weights) != len(lists) {
+		return nil, nil, ErrWeightsMismatch
+	}
+	if aggregate < AggregateSum || aggregate > AggregateMax {
+		return nil, nil, ErrInvalidAggregate
+	}
+
+	acc := make(map[interface{}]*aggregated)
+	var order []interface{}
+	for i, sl := range lists {
+		weight := 1.0
+		if weights != nil {
+			weight = weights[i]
+		}
+
+		for x := sl.header.level[0].forward; x != nil; x = x.level[0].forward {
+			if !hashable(x.obj) {
+				return nil, nil, ErrUnhashableMember
+			}
+			score := x.score * weight
+			// 0 * ±Inf is NaN, Redis counts it as 0
+			if math.IsNaN(score) {
+				score = 0
+			}
+
+			a, found := acc[x.obj]
+			if !found {
+				acc[x.obj] = &aggregated{score: score, seen: 1, last: i}
+				order = append(order, x.obj)
+				continue
+			}
+			a.score = combineScores(a.score, score, aggregate)
+			if a.last != i {
+				a.seen++
+				a.last = i
+			}
+		}
+	}
+	return acc, order, nil
+}
+
+// loadSorted sorts elements by score, then member, and loads them into a new list in O(n)
+// The sort is stable, so members that utils.CompareObjects orders as equal keep the order they were found in.
+func loadSorted(elements []Element) (*List, error) {
+	slices.SortStableFunc(elements, compareElements)
+	result := New()
+	if err := result.BulkLoad(elements); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// combineScores combines two weighted scores with aggregate
+func combineScores(current, score float64, aggregate Aggregate) float64 {
+	switch aggregate {
+	case AggregateMin:
+		return math.Min(current, score)
+	case AggregateMax:
+		return math.Max(current, score)
+	default:
+		// +Inf + -Inf is NaN, Redis counts it as 0
+		if sum := current + score; !math.IsNaN(sum) {
+			return sum
+		}
+		return 0
+	}
+}
+
+// setLists returns the skip lists backing the sorted sets
+func setLists(sets []*SortedSet) []*List {
+	lists := make([]*List, 0, len(sets))
+	for _, z := range sets {
+		lists = append(lists, z.list)
+	}
+	return lists
+}
+
+// newSortedSetFrom creates a sorted set around a list whose members are unique
+// The list starts tracking its members like one created with NewUnique.
+func newSortedSetFrom(sl *List) *SortedSet {
+	z := &SortedSet{list: sl, dict: make(map[interface{}]float64, sl.Size())}
+	sl.members = make(map[interface{}]*Node, sl.Size())
+	for x := sl.header.level[0].forward; x != nil; x = x.level[0].forward {
+		z.dict[x.obj] = x.score
+		sl.members[x.obj] = x
+	}
+	return z
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// newListOf builds a list from score/member pairs
+func newListOf(elements ...Element) *List {
+	sl := New()
+	for _, e := range elements {
+		sl.Add(e.Score, e.Member)
+	}
+	return sl
+}
+
+// listElements returns the elements of a list in order
+func listElements(sl *List) []Element {
+	return sl.PopMin(sl.Size())
+}
+
+func TestUnionStore(t *testing.T) {
+	a := newListOf(Element{1, "x"}, Element{2, "y"})
+	b := newListOf(Element{10, "y"}, Element{20, "z"})
+
+	tests := []struct {
+		name      string
+		weights   []float64
+		aggregate Aggregate
+		expected  []Element
+	}{
+		{"sum", nil, AggregateSum, []Element{{1, "x"}, {12, "y"}, {20, "z"}}},
+		{"weighted sum", []float64{2, 0.5}, AggregateSum, []Element{{2, "x"}, {9, "y"}, {10, "z"}}},
+		{"min", nil, AggregateMin, []Element{{1, "x"}, {2, "y"}, {20, "z"}}},
+		{"max", []float64{1, -1}, AggregateMax, []Element{{-20, "z"}, {1, "x"}, {2, "y"}}},
+	}
+
+	for _, tt := range tests {
+		result, err := UnionStore([]*List{a, b}, tt.weights, tt.aggregate)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", tt.name, err)
+		}
+		checkSpans(t, result)
+		if got := listElements(result); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, got)
+		}
+	}
+
+	// The inputs are left untouched
+	if a.Size() != 2 || b.Size() != 2 {
+		t.Errorf("Expected the inputs to be unchanged")
+	}
+}
+
+func TestInterStore(t *testing.T) {
+	a := newListOf(Element{1, "x"}, Element{2, "y"}, Element{3, "z"})
+	b := newListOf(Element{10, "y"}, Element{20, "z"})
+	c := newListOf(Element{100, "z"}, Element{5, "y"}, Element{7, "w"})
+
+	result, err := InterStore([]*List{a, b, c}, []float64{1, 1, 0.1}, AggregateSum)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if got := listElements(result); !reflect.DeepEqual(got, []Element{{12.5, "y"}, {33, "z"}}) {
+		t.Errorf("Expected [y z], got %v", got)
+	}
+
+	// A member found twice in one list is still missing from the other
+	dup := newListOf(Element{1, "x"}, Element{2, "x"})
+	result, _ = InterStore([]*List{dup, b}, nil, AggregateMax)
+	if !result.IsEmpty() {
+		t.Errorf("Expected an empty intersection, got %v", result)
+	}
+
+	result, _ = InterStore(nil, nil, AggregateSum)
+	if !result.IsEmpty() {
+		t.Errorf("Expected no lists to give an empty list")
+	}
+}
+
+func TestUnionStore_NaN(t *testing.T) {
+	a := newListOf(Element{math.Inf(1), "x"}, Element{math.Inf(1), "y"})
+	b := newListOf(Element{math.Inf(-1), "x"})
+
+	// Inf + -Inf and 0 * Inf are NaN, which count as 0
+	result, _ := UnionStore([]*List{a, b}, []float64{1, 1}, AggregateSum)
+	if got := listElements(result); !reflect.DeepEqual(got, []Element{{0, "x"}, {math.Inf(1), "y"}}) {
+		t.Errorf("Unexpected result %v", got)
+	}
+	result, _ = UnionStore([]*List{a}, []float64{0}, AggregateSum)
+	if got := listElements(result); !reflect.DeepEqual(got, []Element{{0, "x"}, {0, "y"}}) {
+		t.Errorf("Unexpected result %v", got)
+	}
+}
+
+func TestUnionStore_Errors(t *testing.T) {
+	a := newListOf(Element{1, "x"})
+	if _, err := UnionStore([]*List{a}, []float64{1, 2}, AggregateSum); err != ErrWeightsMismatch {
+		t.Errorf("Expected ErrWeightsMismatch, got %v", err)
+	}
+	if _, err := InterStore([]*List{a}, nil, Aggregate(42)); err != ErrInvalidAggregate {
+		t.Errorf("Expected ErrInvalidAggregate, got %v", err)
+	}
+
+	// Members are map keys, unhashable ones are reported instead of panicking
+	unhashable := newListOf(Element{1, []int{1}})
+	if _, err := UnionStore([]*List{a, unhashable}, nil, AggregateSum); err != ErrUnhashableMember {
+		t.Errorf("Expected ErrUnhashableMember from UnionStore, got %v", err)
+	}
+	if _, err := DiffStore([]*List{a, unhashable}); err != ErrUnhashableMember {
+		t.Errorf("Expected ErrUnhashableMember from DiffStore, got %v", err)
+	}
+}
+
+func TestDiffStore(t *testing.T) {
+	a := newListOf(Element{1, "x"}, Element{2, "y"}, Element{3, "z"})
+	b := newListOf(Element{10, "y"})
+	c := newListOf(Element{10, "w"}, Element{30, "z"})
+
+	diff, err := DiffStore([]*List{a, b, c})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if got := listElements(diff); !reflect.DeepEqual(got, []Element{{1, "x"}}) {
+		t.Errorf("Expected [x], got %v", got)
+	}
+	if got, err := DiffStore(nil); err != nil || !got.IsEmpty() {
+		t.Errorf("Expected no lists to give an empty list, got %v (%v)", got, err)
+	}
+}
+
+func TestSortedSet_Aggregate(t *testing.T) {
+	a, b := NewSortedSet(), NewSortedSet()
+	_, _ = a.ZAdd(0, Element{1, "x"}, Element{2, "y"})
+	_, _ = b.ZAdd(0, Element{3, "y"}, Element{4, "z"})
+
+	union, err := ZUnionStore([]*SortedSet{a, b}, nil, AggregateSum)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if score, _ := union.ZScore("y"); score != 5 || union.ZCard() != 3 || union.ZRank("z") != 1 {
+		t.Errorf("Unexpected union %v", union)
+	}
+
+	inter, _ := ZInterStore([]*SortedSet{a, b}, []float64{2, 1}, AggregateMin)
+	if inter.String() != "SortedSet elements: [y: 3]" {
+		t.Errorf("Unexpected intersection %v", inter)
+	}
+
+	diff, err := ZDiffStore([]*SortedSet{a, b})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if diff.String() != "SortedSet elements: [x: 1]" || diff.ZCard() != 1 {
+		t.Errorf("Unexpected difference %v", diff)
+	}
+
+	// The result is a regular sorted set
+	_, _ = diff.ZAdd(0, Element{0, "w"})
+	if diff.ZRank("w") != 0 {
+		t.Errorf("Expected the result to accept new members")
+	}
+	if _, err := ZUnionStore([]*SortedSet{a}, []float64{}, AggregateSum); err != ErrWeightsMismatch {
+		t.Errorf("Expected ErrWeightsMismatch, got %v", err)
+	}
+}
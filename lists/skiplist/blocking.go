@@ -0,0 +1,131 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// Reference: https://redis.io/docs/latest/commands/bzpopmin/
+
+package skiplist
+
+import (
+	"context"
+	"time"
+)
+
+// NewBlocking creates a new empty goroutine-safe skip list
+func NewBlocking() *BlockingList {
+	return &BlockingList{list: New()}
+}
+
+// Add adds a new element and wakes up the consumers waiting for one
+// It returns false if score is NaN, which cannot be stored.
+func (b *BlockingList) Add(score float64, obj interface{}) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.list.Add(score, obj) == nil {
+		return false
+	}
+	if b.ready != nil {
+		close(b.ready)
+		b.ready = nil
+	}
+	return true
+}
+
+// Remove removes an element by score and value
+func (b *BlockingList) Remove(score float64, obj interface{}) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.list.Remove(score, obj)
+}
+
+// Contains checks if an element exists in the skip list
+func (b *BlockingList) Contains(score float64, obj interface{}) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.list.Contains(score, obj)
+}
+
+// PopMin atomically removes and returns up to n elements with the lowest scores, without waiting
+func (b *BlockingList) PopMin(n int) []Element {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.list.PopMin(n)
+}
+
+// PopMax atomically removes and returns up to n elements with the highest scores, without waiting
+func (b *BlockingList) PopMax(n int) []Element {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.list.PopMax(n)
+}
+
+// BlockingPopMin removes and returns the element with the lowest score, waiting for one if the list is empty
+// A zero timeout waits until ctx is done and a negative one does not wait at all.
+// It returns ErrTimeout if the timeout expires first, or the error of ctx if it is done first.
+// When several consumers wait, each added element is taken by one of them.
+func (b *BlockingList) BlockingPopMin(ctx context.Context, timeout time.Duration) (Element, error) {
+	return b.blockingPop(ctx, timeout, (*List).PopMin)
+}
+
+// BlockingPopMax removes and returns the element with the highest score, waiting for one if the list is empty
+// timeout and the errors behave as in BlockingPopMin.
+func (b *BlockingList) BlockingPopMax(ctx context.Context, timeout time.Duration) (Element, error) {
+	return b.blockingPop(ctx, timeout, (*List).PopMax)
+}
+
+// Size returns the number of elements in the skip list
+func (b *BlockingList) Size() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.list.Size()
+}
+
+// IsEmpty checks if the skip list is empty
+func (b *BlockingList) IsEmpty() bool {
+	return b.Size() == 0
+}
+
+// String returns a string representation of the skip list
+func (b *BlockingList) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.list.String()
+}
+
+// blockingPop pops one element with pop, waiting for an Add while the list is empty
+func (b *BlockingList) blockingPop(ctx context.Context, timeout time.Duration, pop func(sl *List, n int) []Element) (Element, error) {
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		b.mu.Lock()
+		if elements := pop(b.list, 1); len(elements) > 0 {
+			b.mu.Unlock()
+			return elements[0], nil
+		}
+		if timeout < 0 {
+			b.mu.Unlock()
+			return Element{}, ErrTimeout
+		}
+		// Register as a waiter, the next Add closes the channel
+		if b.ready == nil {
+			b.ready = make(chan struct{})
+		}
+		ready := b.ready
+		b.mu.Unlock()
+
+		// Another consumer may take the element first, then wait again
+		select {
+		case <-ready:
+		case <-expired:
+			return Element{}, ErrTimeout
+		case <-ctx.Done():
+			return Element{}, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingList_PopWithoutWaiting(t *testing.T) {
+	b := NewBlocking()
+	b.Add(2, "b")
+	b.Add(1, "a")
+	b.Add(3, "c")
+	if b.Add(math.NaN(), "nan") {
+		t.Errorf("Expected NaN scores to be rejected")
+	}
+
+	if e, err := b.BlockingPopMin(context.Background(), time.Second); err != nil || e.Member != "a" {
+		t.Errorf("Expected a, got %v (%v)", e, err)
+	}
+	if e, err := b.BlockingPopMax(context.Background(), 0); err != nil || e.Member != "c" {
+		t.Errorf("Expected c, got %v (%v)", e, err)
+	}
+	if got := b.PopMin(5); len(got) != 1 || got[0].Member != "b" || !b.IsEmpty() {
+		t.Errorf("Expected to pop b last, got %v", got)
+	}
+
+	// A negative timeout never waits
+	if _, err := b.BlockingPopMin(context.Background(), -1); err != ErrTimeout {
+		t.Errorf("Expected ErrTimeout, got %v", err)
+	}
+}
+
+func TestBlockingList_WaitForAdd(t *testing.T) {
+	b := NewBlocking()
+
+	result := make(chan Element)
+	go func() {
+		e, err := b.BlockingPopMin(context.Background(), 0)
+		if err != nil {
+			t.Errorf("Unexpected error %v", err)
+		}
+		result <- e
+	}()
+
+	// Give the consumer a chance to block first, the result is the same either way
+	time.Sleep(10 * time.Millisecond)
+	b.Add(5, "due")
+
+	select {
+	case e := <-result:
+		if e.Member != "due" || e.Score != 5 {
+			t.Errorf("Expected due with score 5, got %v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the blocked consumer to be woken up")
+	}
+}
+
+func TestBlockingList_TimeoutAndCancel(t *testing.T) {
+	b := NewBlocking()
+
+	start := time.Now()
+	if _, err := b.BlockingPopMin(context.Background(), 20*time.Millisecond); err != ErrTimeout {
+		t.Errorf("Expected ErrTimeout, got %v", err)
+	}
+	if time.Since(start) < 20*time.Millisecond {
+		t.Errorf("Expected to wait for the timeout")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := b.BlockingPopMax(ctx, 0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestBlockingList_Concurrent(t *testing.T) {
+	const producers, consumers, perProducer = 4, 4, 250
+	b := NewBlocking()
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				b.Add(float64(i), p*perProducer+i)
+			}
+		}(p)
+	}
+
+	// Every element is taken exactly once
+	var mu sync.Mutex
+	seen := make(map[interface{}]bool)
+	var consumersWg sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		consumersWg.Add(1)
+		go func() {
+			defer consumersWg.Done()
+			for {
+				e, err := b.BlockingPopMin(context.Background(), 200*time.Millisecond)
+				if err == ErrTimeout {
+					return
+				}
+				mu.Lock()
+				if seen[e.Member] {
+					t.Errorf("Element %v popped twice", e.Member)
+				}
+				seen[e.Member] = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	consumersWg.Wait()
+	if len(seen) != producers*perProducer || b.Size() != 0 {
+		t.Errorf("Expected %d popped elements and an empty list, got %d and %d", producers*perProducer, len(seen), b.Size())
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import "errors"
+
+// ZAddFlag modifies the behavior of SortedSet.ZAdd, flags can be combined with |
+type ZAddFlag int
+
+const (
+	ZAddNX ZAddFlag = 1 << iota // Only add new members, never update existing ones
+	ZAddXX                      // Only update existing members, never add new ones
+	ZAddGT                      // Only update existing members if the new score is greater
+	ZAddLT                      // Only update existing members if the new score is less
+	ZAddCH                      // Count changed members in the result, not only added ones
+)
+
+// Aggregate selects how UnionStore and InterStore combine the scores of a member found in several lists
+type Aggregate int
+
+const (
+	AggregateSum Aggregate = iota // Add the weighted scores up, like AGGREGATE SUM in Redis
+	AggregateMin                  // Keep the lowest weighted score
+	AggregateMax                  // Keep the highest weighted score
+)
+
+var (
+	// ErrNaNScore is returned when an operation would store a NaN score
+	ErrNaNScore = errors.New("skiplist: resulting score is not a number (NaN)")
+	// ErrIncompatibleFlags is returned when ZAdd receives a combination of flags that cannot be honored
+	ErrIncompatibleFlags = errors.New("skiplist: NX, XX, GT and LT flags are not compatible in this combination")
+	// ErrInvalidLexBound is returned when a lexicographic bound does not start with '[' or '(', and is not '-' or '+'
+	ErrInvalidLexBound = errors.New("skiplist: min or max not valid string range item")
+	// ErrIteratorInvalidated is returned by Iterator.Err when the list changed during the iteration
+	ErrIteratorInvalidated = errors.New("skiplist: list was modified during iteration")
+	// ErrTimeout is returned by blocking pops when no element arrived before the timeout
+	ErrTimeout = errors.New("skiplist: timed out waiting for an element")
+	// ErrWeightsMismatch is returned when the number of weights differs from the number of lists
+	ErrWeightsMismatch = errors.New("skiplist: number of weights does not match the number of lists")
+	// ErrInvalidAggregate is returned when an unknown Aggregate value is given
+	ErrInvalidAggregate = errors.New("skiplist: unknown aggregate function")
+	// ErrNotSorted is returned by BulkLoad when the elements are not sorted by score, then member
+	ErrNotSorted = errors.New("skiplist: elements are not sorted by score and member")
+	// ErrDuplicateMember is returned by BulkLoad when a list with unique members receives a member twice
+	ErrDuplicateMember = errors.New("skiplist: member appears more than once")
+	// ErrUnhashableMember is returned by BulkLoad when a list with unique members receives a member that is not comparable
+	ErrUnhashableMember = errors.New("skiplist: unique members must be comparable")
+	// ErrInvalidSnapshot is returned when reading data that is not a complete snapshot written by WriteTo
+	ErrInvalidSnapshot = errors.New("skiplist: invalid or truncated snapshot")
+)
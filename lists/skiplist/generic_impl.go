@@ -0,0 +1,515 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// NewGeneric creates a new generic skip list
+// compare orders members with equal scores and must return a negative number, zero or a positive number
+// when a is less than, equal to or greater than b. It only orders members and locates them for Find, Remove and Rank:
+// Add does not deduplicate, so adding a member that compares equal to an existing one with the same score adds a second element.
+func NewGeneric[S cmp.Ordered, M any](compare func(a, b M) int) *Generic[S, M] {
+	return NewGenericWithOptions[S](compare, MaxLevel, Probability, nil)
+}
+
+// NewGenericWithOptions creates a new generic skip list with a custom level distribution
+// maxLevel, probability and source behave as in NewWithOptions, a maxLevel above MaxLevelLimit is lowered to the limit.
+func NewGenericWithOptions[S cmp.Ordered, M any](compare func(a, b M) int,
+	maxLevel int, probability float64, source rand.Source) *Generic[S, M] {
+	var score S
+	var member M
+	levels := newLevelGenerator(maxLevel, probability, source)
+	return &Generic[S, M]{
+		header:  newGenericNode(levels.maxLevel, score, member),
+		level:   1,
+		compare: compare,
+		levels:  levels,
+	}
+}
+
+// NewOrdered creates a new generic skip list whose members are ordered with cmp.Compare
+func NewOrdered[S, M cmp.Ordered]() *Generic[S, M] {
+	return NewGeneric[S](cmp.Compare[M])
+}
+
+// newGenericNode creates and returns a new node with level levels
+func newGenericNode[S cmp.Ordered, M any](level int, score S, member M) *GenericNode[S, M] {
+	return &GenericNode[S, M]{
+		level:  make([]genericLevel[S, M], level),
+		score:  score,
+		member: member,
+	}
+}
+
+// GetScore returns the score of the node
+func (n *GenericNode[S, M]) GetScore() S {
+	return n.score
+}
+
+// GetMember returns the member stored in the node
+func (n *GenericNode[S, M]) GetMember() M {
+	return n.member
+}
+
+// Next returns the node that follows n, or nil if n is the last node
+func (n *GenericNode[S, M]) Next() *GenericNode[S, M] {
+	return n.level[0].forward
+}
+
+// Prev returns the node that precedes n, or nil if n is the first node
+func (n *GenericNode[S, M]) Prev() *GenericNode[S, M] {
+	return n.backward
+}
+
+// Add adds a new element to the skip list and returns its node
+// It returns nil if score is NaN, which has no place in the order.
+func (sl *Generic[S, M]) Add(score S, member M) *GenericNode[S, M] {
+	if isNaN(score) {
+		return nil
+	}
+
+	// Find the last node before the new one at each level, and its rank
+	update := make([]*GenericNode[S, M], sl.levels.maxLevel)
+	rank := make([]int, sl.levels.maxLevel)
+	x := sl.header
+	for i := sl.level - 1; i >= 0; i-- {
+		if i < sl.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.level[i].forward != nil && sl.less(x.level[i].forward, score, member) {
+			rank[i] += x.level[i].span
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+
+	// Levels above the current maximum start from the header, which spans the whole list
+	level := sl.levels.next()
+	if level > sl.level {
+		for i := sl.level; i < level; i++ {
+			update[i] = sl.header
+			update[i].level[i].span = sl.length
+		}
+		sl.level = level
+	}
+
+	x = newGenericNode(level, score, member)
+	for i := 0; i < level; i++ {
+		x.level[i].forward = update[i].level[i].forward
+		update[i].level[i].forward = x
+
+		x.level[i].span = update[i].level[i].span - (rank[0] - rank[i])
+		update[i].level[i].span = (rank[0] - rank[i]) + 1
+	}
+
+	// Levels above the new node now span one more node
+	for i := level; i < sl.level; i++ {
+		update[i].level[i].span++
+	}
+
+	if update[0] != sl.header {
+		x.backward = update[0]
+	}
+	if x.level[0].forward != nil {
+		x.level[0].forward.backward = x
+	} else {
+		sl.tail = x
+	}
+
+	sl.length++
+	return x
+}
+
+// Remove removes an element by score and member and reports whether it was found
+func (sl *Generic[S, M]) Remove(score S, member M) bool {
+	update := make([]*GenericNode[S, M], sl.level)
+	x := sl.header
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && sl.less(x.level[i].forward, score, member) {
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+
+	x = x.level[0].forward
+	if x == nil || !sl.matches(x, score, member) {
+		return false
+	}
+	sl.removeNode(x, update)
+	return true
+}
+
+// Find finds an element by score and member
+// Return nil if not found
+func (sl *Generic[S, M]) Find(score S, member M) *GenericNode[S, M] {
+	x := sl.Ceiling(score, member)
+	if x == nil || !sl.matches(x, score, member) {
+		return nil
+	}
+	return x
+}
+
+// Ceiling returns the first node that is not less than the element score/member
+// Return nil if every node is less, the element itself does not need to exist.
+func (sl *Generic[S, M]) Ceiling(score S, member M) *GenericNode[S, M] {
+	x := sl.header
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && sl.less(x.level[i].forward, score, member) {
+			x = x.level[i].forward
+		}
+	}
+	return x.level[0].forward
+}
+
+// Contains checks if an element exists in the skip list
+func (sl *Generic[S, M]) Contains(score S, member M) bool {
+	return sl.Find(score, member) != nil
+}
+
+// Rank returns the 1-based rank of an element
+// Return -1 if not found
+func (sl *Generic[S, M]) Rank(score S, member M) int {
+	x, rank := sl.header, 0
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && sl.less(x.level[i].forward, score, member) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+	}
+
+	if x = x.level[0].forward; x == nil || !sl.matches(x, score, member) {
+		return -1
+	}
+	return rank + 1
+}
+
+// GetByRank returns the node at the 1-based rank
+// Return nil if rank is out of bounds
+func (sl *Generic[S, M]) GetByRank(rank int) *GenericNode[S, M] {
+	if rank < 1 || rank > sl.length {
+		return nil
+	}
+
+	x, traversed := sl.header, 0
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && traversed+x.level[i].span <= rank {
+			traversed += x.level[i].span
+			x = x.level[i].forward
+		}
+		if traversed == rank {
+			return x
+		}
+	}
+	return nil
+}
+
+// First returns the node with the lowest score, or nil if the list is empty
+func (sl *Generic[S, M]) First() *GenericNode[S, M] {
+	return sl.header.level[0].forward
+}
+
+// Last returns the node with the highest score, or nil if the list is empty
+func (sl *Generic[S, M]) Last() *GenericNode[S, M] {
+	return sl.tail
+}
+
+// Each calls fn for every element in ascending order until fn returns false
+func (sl *Generic[S, M]) Each(fn func(score S, member M) bool) {
+	for x := sl.header.level[0].forward; x != nil; x = x.level[0].forward {
+		if !fn(x.score, x.member) {
+			return
+		}
+	}
+}
+
+// Size returns the number of elements in the skip list
+func (sl *Generic[S, M]) Size() int {
+	return sl.length
+}
+
+// IsEmpty checks if the skip list is empty
+func (sl *Generic[S, M]) IsEmpty() bool {
+	return sl.length == 0
+}
+
+// String returns a string representation of the skip list
+func (sl *Generic[S, M]) String() string {
+	var elements []string
+	for x := sl.header.level[0].forward; x != nil; x = x.level[0].forward {
+		elements = append(elements, fmt.Sprintf("score: %v, member: %v", x.score, x.member))
+	}
+	return fmt.Sprintf("SkipList elements: [%s]", strings.Join(elements, ", "))
+}
+
+// RangeByScore returns the nodes whose score is within r, from the lowest to the highest score
+// offset and limit behave as in List.RangeByScore.
+func (sl *Generic[S, M]) RangeByScore(r GenericScoreRange[S], offset, limit int) []*GenericNode[S, M] {
+	x, rank := sl.firstInScoreRange(r)
+	if x == nil || offset < 0 {
+		return nil
+	}
+
+	if offset > 0 {
+		x = sl.GetByRank(rank + offset)
+	}
+
+	var nodes []*GenericNode[S, M]
+	for ; x != nil && limit != 0 && r.lteMax(x.score); x = x.level[0].forward {
+		nodes = append(nodes, x)
+		limit--
+	}
+	return nodes
+}
+
+// RevRangeByScore returns the nodes whose score is within r, from the highest to the lowest score
+// offset and limit behave as in List.RevRangeByScore.
+func (sl *Generic[S, M]) RevRangeByScore(r GenericScoreRange[S], offset, limit int) []*GenericNode[S, M] {
+	x, rank := sl.lastInScoreRange(r)
+	if x == nil || offset < 0 {
+		return nil
+	}
+
+	if offset > 0 {
+		x = sl.GetByRank(rank - offset)
+	}
+
+	var nodes []*GenericNode[S, M]
+	for ; x != nil && limit != 0 && r.gteMin(x.score); x = x.backward {
+		nodes = append(nodes, x)
+		limit--
+	}
+	return nodes
+}
+
+// RangeByRank returns the nodes between the 0-based ranks start and stop, both inclusive
+// Negative indexes count from the end, like List.RangeByRank.
+func (sl *Generic[S, M]) RangeByRank(start, stop int) []*GenericNode[S, M] {
+	start, stop, ok := normalizeRankRange(start, stop, sl.length)
+	if !ok {
+		return nil
+	}
+
+	nodes := make([]*GenericNode[S, M], 0, stop-start+1)
+	for x := sl.GetByRank(start + 1); len(nodes) < cap(nodes); x = x.level[0].forward {
+		nodes = append(nodes, x)
+	}
+	return nodes
+}
+
+// RevRangeByRank returns the nodes between the 0-based ranks start and stop counted from the highest score
+func (sl *Generic[S, M]) RevRangeByRank(start, stop int) []*GenericNode[S, M] {
+	start, stop, ok := normalizeRankRange(start, stop, sl.length)
+	if !ok {
+		return nil
+	}
+
+	nodes := make([]*GenericNode[S, M], 0, stop-start+1)
+	for x := sl.GetByRank(sl.length - start); len(nodes) < cap(nodes); x = x.backward {
+		nodes = append(nodes, x)
+	}
+	return nodes
+}
+
+// CountInScore returns the number of nodes whose score is within r, in O(log n)
+func (sl *Generic[S, M]) CountInScore(r GenericScoreRange[S]) int {
+	if r.isEmpty() {
+		return 0
+	}
+	return sl.countWhile(r.lteMax) - sl.countWhile(func(score S) bool { return !r.gteMin(score) })
+}
+
+// RemoveRangeByScore removes every node whose score is within r and returns the number of removed nodes
+// If fn is not nil, it is called with each removed node in ascending order.
+func (sl *Generic[S, M]) RemoveRangeByScore(r GenericScoreRange[S], fn func(node *GenericNode[S, M])) int {
+	if r.isEmpty() {
+		return 0
+	}
+
+	update := make([]*GenericNode[S, M], sl.level)
+	x := sl.header
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && !r.gteMin(x.level[i].forward.score) {
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+
+	return sl.removeRun(update, x.level[0].forward, func(node *GenericNode[S, M]) bool {
+		return r.lteMax(node.score)
+	}, fn)
+}
+
+// RemoveRangeByRank removes the nodes between the 0-based ranks start and stop, both inclusive,
+// and returns the number of removed nodes. If fn is not nil, it is called with each removed node.
+func (sl *Generic[S, M]) RemoveRangeByRank(start, stop int, fn func(node *GenericNode[S, M])) int {
+	start, stop, ok := normalizeRankRange(start, stop, sl.length)
+	if !ok {
+		return 0
+	}
+
+	update := make([]*GenericNode[S, M], sl.level)
+	x, traversed := sl.header, 0
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && traversed+x.level[i].span <= start {
+			traversed += x.level[i].span
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+
+	remaining := stop - start + 1
+	return sl.removeRun(update, x.level[0].forward, func(*GenericNode[S, M]) bool {
+		remaining--
+		return remaining >= 0
+	}, fn)
+}
+
+// less checks if node x comes before the element score/member
+func (sl *Generic[S, M]) less(x *GenericNode[S, M], score S, member M) bool {
+	if c := cmp.Compare(x.score, score); c != 0 {
+		return c < 0
+	}
+	return sl.compare(x.member, member) < 0
+}
+
+// matches checks if node x holds the element score/member
+func (sl *Generic[S, M]) matches(x *GenericNode[S, M], score S, member M) bool {
+	return cmp.Compare(x.score, score) == 0 && sl.compare(x.member, member) == 0
+}
+
+// removeNode unlinks x, update must hold the last node before x at each level
+func (sl *Generic[S, M]) removeNode(x *GenericNode[S, M], update []*GenericNode[S, M]) {
+	for i := 0; i < sl.level; i++ {
+		if update[i].level[i].forward == x {
+			update[i].level[i].span += x.level[i].span - 1
+			update[i].level[i].forward = x.level[i].forward
+		} else {
+			update[i].level[i].span--
+		}
+	}
+
+	if x.level[0].forward != nil {
+		x.level[0].forward.backward = x.backward
+	} else {
+		sl.tail = x.backward
+	}
+
+	for sl.level > 1 && sl.header.level[sl.level-1].forward == nil {
+		sl.level--
+	}
+	sl.length--
+}
+
+// removeRun unlinks consecutive nodes starting at x for as long as inRange accepts them
+func (sl *Generic[S, M]) removeRun(update []*GenericNode[S, M], x *GenericNode[S, M],
+	inRange func(node *GenericNode[S, M]) bool, fn func(node *GenericNode[S, M])) int {
+	removed := 0
+	for x != nil && inRange(x) {
+		next := x.level[0].forward
+		sl.removeNode(x, update)
+		if fn != nil {
+			fn(x)
+		}
+		removed++
+		x = next
+	}
+	return removed
+}
+
+// firstInScoreRange returns the first node whose score is within r and its 1-based rank
+func (sl *Generic[S, M]) firstInScoreRange(r GenericScoreRange[S]) (*GenericNode[S, M], int) {
+	if r.isEmpty() {
+		return nil, 0
+	}
+
+	x, rank := sl.header, 0
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && !r.gteMin(x.level[i].forward.score) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+	}
+
+	x = x.level[0].forward
+	if x == nil || !r.lteMax(x.score) {
+		return nil, 0
+	}
+	return x, rank + 1
+}
+
+// lastInScoreRange returns the last node whose score is within r and its 1-based rank
+func (sl *Generic[S, M]) lastInScoreRange(r GenericScoreRange[S]) (*GenericNode[S, M], int) {
+	if r.isEmpty() {
+		return nil, 0
+	}
+
+	x, rank := sl.header, 0
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && r.lteMax(x.level[i].forward.score) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+	}
+
+	if x == sl.header || !r.gteMin(x.score) {
+		return nil, 0
+	}
+	return x, rank
+}
+
+// countWhile returns the number of leading nodes whose score satisfies the monotonic pred
+func (sl *Generic[S, M]) countWhile(pred func(score S) bool) int {
+	x, rank := sl.header, 0
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && pred(x.level[i].forward.score) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+	}
+	return rank
+}
+
+// gteMin checks if score satisfies the min bound of the range
+func (r GenericScoreRange[S]) gteMin(score S) bool {
+	switch {
+	case r.MinUnbounded:
+		return true
+	case r.MinExclusive:
+		return cmp.Compare(score, r.Min) > 0
+	default:
+		return cmp.Compare(score, r.Min) >= 0
+	}
+}
+
+// lteMax checks if score satisfies the max bound of the range
+func (r GenericScoreRange[S]) lteMax(score S) bool {
+	switch {
+	case r.MaxUnbounded:
+		return true
+	case r.MaxExclusive:
+		return cmp.Compare(score, r.Max) < 0
+	default:
+		return cmp.Compare(score, r.Max) <= 0
+	}
+}
+
+// isEmpty checks if no score can satisfy both bounds
+func (r GenericScoreRange[S]) isEmpty() bool {
+	if r.MinUnbounded || r.MaxUnbounded {
+		return false
+	}
+	c := cmp.Compare(r.Min, r.Max)
+	return c > 0 || (c == 0 && (r.MinExclusive || r.MaxExclusive))
+}
+
+// isNaN checks if score is a floating-point NaN, the only value that differs from itself
+func isNaN[S cmp.Ordered](score S) bool {
+	return score != score
+}
@@ -0,0 +1,268 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// genericMembers returns the members of the nodes, to compare results easily
+func genericMembers[S cmp.Ordered, M any](nodes []*GenericNode[S, M]) []M {
+	members := make([]M, 0, len(nodes))
+	for _, node := range nodes {
+		members = append(members, node.GetMember())
+	}
+	return members
+}
+
+// newGenericTestList builds a list holding the members a..j with scores 1..10
+func newGenericTestList() *Generic[int, string] {
+	sl := NewOrdered[int, string]()
+	for i, member := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		sl.Add(i+1, member)
+	}
+	return sl
+}
+
+func TestGeneric_AddRemove(t *testing.T) {
+	sl := NewOrdered[float64, string]()
+	if !sl.IsEmpty() || sl.First() != nil || sl.Last() != nil {
+		t.Errorf("Expected new list to be empty")
+	}
+
+	sl.Add(2, "b")
+	sl.Add(1, "a")
+	sl.Add(2, "a")
+	if node := sl.Add(math.NaN(), "nan"); node != nil {
+		t.Errorf("Expected NaN scores to be rejected")
+	}
+
+	if sl.Size() != 3 {
+		t.Errorf("Expected size 3, got %d", sl.Size())
+	}
+	if sl.First().GetMember() != "a" || sl.Last().GetMember() != "b" || sl.Last().GetScore() != 2 {
+		t.Errorf("Unexpected order: %v", sl)
+	}
+	if sl.First().Prev() != nil || sl.First().Next().GetMember() != "a" {
+		t.Errorf("Unexpected links: %v", sl)
+	}
+	if !sl.Contains(2, "a") || sl.Contains(1, "b") {
+		t.Errorf("Unexpected Contains results")
+	}
+
+	if sl.Remove(3, "a") || !sl.Remove(2, "a") || sl.Contains(2, "a") {
+		t.Errorf("Unexpected Remove results")
+	}
+	if sl.Size() != 2 || sl.Rank(2, "b") != 2 {
+		t.Errorf("Expected b at rank 2, got %d", sl.Rank(2, "b"))
+	}
+
+	expected := "SkipList elements: [score: 1, member: a, score: 2, member: b]"
+	if sl.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, sl.String())
+	}
+}
+
+func TestGeneric_TypedOrdering(t *testing.T) {
+	// CompareObjects would order 10 before 9 by their string forms, cmp.Compare does not
+	sl := NewOrdered[int64, int64]()
+	for _, member := range []int64{10, 9, 100, -1} {
+		sl.Add(0, member)
+	}
+
+	var members []int64
+	sl.Each(func(_ int64, member int64) bool {
+		members = append(members, member)
+		return true
+	})
+	if !reflect.DeepEqual(members, []int64{-1, 9, 10, 100}) {
+		t.Errorf("Expected numeric order, got %v", members)
+	}
+}
+
+func TestGeneric_CustomComparator(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+
+	// Members are identified by ID only
+	sl := NewGeneric[uint8](func(a, b user) int { return cmp.Compare(a.ID, b.ID) })
+	sl.Add(5, user{2, "bob"})
+	sl.Add(5, user{1, "alice"})
+	sl.Add(1, user{3, "carol"})
+
+	if got := genericMembers(sl.RangeByRank(0, -1)); !reflect.DeepEqual(got, []user{{3, "carol"}, {1, "alice"}, {2, "bob"}}) {
+		t.Errorf("Unexpected order %v", got)
+	}
+	if node := sl.Find(5, user{ID: 2}); node == nil || node.GetMember().Name != "bob" {
+		t.Errorf("Expected to find bob by ID, got %v", node)
+	}
+	if rank := sl.Rank(5, user{ID: 1}); rank != 2 {
+		t.Errorf("Expected alice at rank 2, got %d", rank)
+	}
+	if rank := sl.Rank(5, user{ID: 3}); rank != -1 {
+		t.Errorf("Expected -1 for a member with another score, got %d", rank)
+	}
+}
+
+func TestGeneric_Ceiling(t *testing.T) {
+	sl := NewOrdered[int, string]()
+	sl.Add(1, "b")
+	sl.Add(1, "d")
+	sl.Add(3, "a")
+
+	tests := []struct {
+		score    int
+		member   string
+		expected string
+	}{
+		{1, "b", "b"},
+		{1, "c", "d"},
+		{0, "z", "b"},
+		{1, "e", "a"},
+		{2, "", "a"},
+	}
+	for _, tt := range tests {
+		if node := sl.Ceiling(tt.score, tt.member); node == nil || node.GetMember() != tt.expected {
+			t.Errorf("Ceiling(%d, %q): expected %s, got %v", tt.score, tt.member, tt.expected, node)
+		}
+	}
+	if node := sl.Ceiling(3, "b"); node != nil {
+		t.Errorf("Expected nil past the last node, got %v", node)
+	}
+}
+
+func TestGeneric_Ranges(t *testing.T) {
+	sl := newGenericTestList()
+
+	if got := genericMembers(sl.RangeByScore(GenericScoreRange[int]{Min: 3, Max: 5}, 0, -1)); !reflect.DeepEqual(got, []string{"c", "d", "e"}) {
+		t.Errorf("RangeByScore: unexpected %v", got)
+	}
+	if got := genericMembers(sl.RangeByScore(GenericScoreRange[int]{Min: 8, MaxUnbounded: true}, 1, 5)); !reflect.DeepEqual(got, []string{"i", "j"}) {
+		t.Errorf("RangeByScore with offset: unexpected %v", got)
+	}
+	if got := genericMembers(sl.RevRangeByScore(GenericScoreRange[int]{MinUnbounded: true, Max: 3, MaxExclusive: true}, 0, -1)); !reflect.DeepEqual(got, []string{"b", "a"}) {
+		t.Errorf("RevRangeByScore: unexpected %v", got)
+	}
+	if got := sl.RangeByScore(GenericScoreRange[int]{Min: 5, Max: 4}, 0, -1); len(got) != 0 {
+		t.Errorf("Expected an inverted range to be empty, got %v", genericMembers(got))
+	}
+	if got := genericMembers(sl.RangeByRank(-2, -1)); !reflect.DeepEqual(got, []string{"i", "j"}) {
+		t.Errorf("RangeByRank: unexpected %v", got)
+	}
+	if got := genericMembers(sl.RevRangeByRank(0, 1)); !reflect.DeepEqual(got, []string{"j", "i"}) {
+		t.Errorf("RevRangeByRank: unexpected %v", got)
+	}
+	if got := sl.CountInScore(GenericScoreRange[int]{Min: 2, Max: 9, MinExclusive: true}); got != 7 {
+		t.Errorf("CountInScore: expected 7, got %d", got)
+	}
+	if got := sl.CountInScore(GenericScoreRange[int]{MinUnbounded: true, MaxUnbounded: true}); got != 10 {
+		t.Errorf("CountInScore: expected 10, got %d", got)
+	}
+}
+
+func TestGeneric_RemoveRange(t *testing.T) {
+	sl := newGenericTestList()
+
+	var removed []string
+	n := sl.RemoveRangeByScore(GenericScoreRange[int]{Min: 3, Max: 5}, func(node *GenericNode[int, string]) {
+		removed = append(removed, node.GetMember())
+	})
+	if n != 3 || !reflect.DeepEqual(removed, []string{"c", "d", "e"}) {
+		t.Errorf("Expected to remove [c d e], removed %d %v", n, removed)
+	}
+	if n := sl.RemoveRangeByRank(0, 0, nil); n != 1 {
+		t.Errorf("Expected 1 removed node, got %d", n)
+	}
+	if got := genericMembers(sl.RangeByRank(0, -1)); !reflect.DeepEqual(got, []string{"b", "f", "g", "h", "i", "j"}) {
+		t.Errorf("Unexpected remaining nodes %v", got)
+	}
+	if sl.First().Prev() != nil || sl.GetByRank(2).GetMember() != "f" {
+		t.Errorf("Unexpected links after removal")
+	}
+}
+
+func TestGeneric_Random(t *testing.T) {
+	type element struct {
+		score  int
+		member int
+	}
+	compare := func(a, b element) int {
+		if c := cmp.Compare(a.score, b.score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.member, b.member)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	sl := NewOrdered[int, int]()
+	var reference []element
+	for i := 0; i < 3000; i++ {
+		e := element{r.Intn(100), r.Intn(1000)}
+		if r.Intn(3) == 0 && len(reference) > 0 {
+			e = reference[r.Intn(len(reference))]
+			if !sl.Remove(e.score, e.member) {
+				t.Fatalf("Expected to remove %v", e)
+			}
+			index, _ := slices.BinarySearchFunc(reference, e, compare)
+			reference = slices.Delete(reference, index, index+1)
+			continue
+		}
+		if index, found := slices.BinarySearchFunc(reference, e, compare); !found {
+			sl.Add(e.score, e.member)
+			reference = slices.Insert(reference, index, e)
+		}
+	}
+
+	if sl.Size() != len(reference) {
+		t.Fatalf("Expected size %d, got %d", len(reference), sl.Size())
+	}
+	for i, e := range reference {
+		if node := sl.GetByRank(i + 1); node == nil || node.GetScore() != e.score || node.GetMember() != e.member {
+			t.Fatalf("Expected %v at rank %d, got %v", e, i+1, node)
+		}
+		if rank := sl.Rank(e.score, e.member); rank != i+1 {
+			t.Fatalf("Expected rank %d for %v, got %d", i+1, e, rank)
+		}
+	}
+}
+
+func TestNewGenericWithOptions(t *testing.T) {
+	build := func() *Generic[int, int] {
+		sl := NewGenericWithOptions[int](cmp.Compare[int], 4, 0.5, rand.NewSource(7))
+		for i := 0; i < 200; i++ {
+			sl.Add(i, i)
+		}
+		return sl
+	}
+
+	levels := func(sl *Generic[int, int]) []int {
+		var levels []int
+		for x := sl.First(); x != nil; x = x.Next() {
+			levels = append(levels, len(x.level))
+		}
+		return levels
+	}
+
+	first, second := build(), build()
+	if !reflect.DeepEqual(levels(first), levels(second)) {
+		t.Errorf("Expected two lists built from the same seed to have the same layout")
+	}
+	if len(first.header.level) != 4 || first.level > 4 {
+		t.Errorf("Expected at most 4 levels, got %d", first.level)
+	}
+	if sl := NewGenericWithOptions[int](cmp.Compare[int], 100, Probability, nil); len(sl.header.level) != MaxLevelLimit {
+		t.Errorf("Expected a maxLevel above the limit to be lowered to %d, got %d", MaxLevelLimit, len(sl.header.level))
+	}
+	if node := first.GetByRank(150); node == nil || node.GetMember() != 149 {
+		t.Errorf("Expected 149 at rank 150, got %v", node)
+	}
+}
@@ -10,28 +10,45 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"reflect"
 	"strings"
 
 	"github.com/ethan-gao-code/go-ds/utils"
 )
 
-// MaxLevel defines the maximum number of levels in the skip list
+// MaxLevel defines the default maximum number of levels in the skip list
+// It keeps the list efficient up to about 2^16 elements with the default probability.
 const MaxLevel = 16
 
-// Probability defines the probability factor for random level generation
+// MaxLevelLimit defines the highest maximum level accepted by NewWithOptions
+const MaxLevelLimit = 64
+
+// Probability defines the default probability factor for random level generation
 const Probability = 0.5
 
 // New creates a new skip list instance
 func New() *List {
+	return NewWithOptions(MaxLevel, Probability, nil)
+}
+
+// NewWithOptions creates a new skip list instance with a custom level distribution
+// maxLevel: maximum number of levels, a list stays O(log n) up to about (1/probability)^maxLevel elements (default MaxLevel)
+// probability: probability for a node to get one more level, within (0, 1) (default Probability)
+// source: source of randomness for the node levels, a seeded source gives reproducible layouts (default math/rand)
+// Invalid values fall back to the defaults, except a maxLevel above MaxLevelLimit which is lowered to the limit.
+func NewWithOptions(maxLevel int, probability float64, source rand.Source) *List {
+	levels := newLevelGenerator(maxLevel, probability, source)
+
 	// Create a new skip list instance
 	sl := &List{
-		level:  1,                         // Start with level 1
-		length: 0,                         // Initially the list is empty
-		header: newNode(MaxLevel, 0, nil), // Create the header node with max level
+		level:  1,                                // Start with level 1
+		length: 0,                                // Initially the list is empty
+		header: newNode(levels.maxLevel, 0, nil), // Create the header node with max level
+		levels: levels,                           // Keep the level distribution
 	}
 
 	// Initialize the header node's levels
-	for i := 0; i < MaxLevel; i++ {
+	for i := 0; i < levels.maxLevel; i++ {
 		sl.header.level[i] = &nodeLevel{
 			forward: nil,
 			span:    0,
@@ -45,6 +62,23 @@ func New() *List {
 	return sl
 }
 
+// NewUnique creates a new skip list instance in which every member appears at most once
+// Adding an existing member moves it to the new score, like ZADD in Redis, so a member has a single rank.
+// Members are tracked in a map and matched with == by Add, Find, Contains, Remove, UpdateScore, Rank
+// and GetByMember, so 1 and "1" are two members even though utils.CompareObjects orders them as equal.
+// Members must be comparable: Add returns nil and BulkLoad returns ErrUnhashableMember for other ones.
+func NewUnique() *List {
+	return NewUniqueWithOptions(MaxLevel, Probability, nil)
+}
+
+// NewUniqueWithOptions creates a new skip list instance with unique members and a custom level distribution
+// The options behave as in NewWithOptions and members as in NewUnique.
+func NewUniqueWithOptions(maxLevel int, probability float64, source rand.Source) *List {
+	sl := NewWithOptions(maxLevel, probability, source)
+	sl.members = make(map[interface{}]*Node)
+	return sl
+}
+
 // newNode creates and returns a new node for the skip list
 func newNode(level int, score float64, obj interface{}) *Node {
 	// Allocate memory for the new node
@@ -67,22 +101,58 @@ func newNode(level int, score float64, obj interface{}) *Node {
 	return node
 }
 
-// randomLevel generates a random level for the new node
-func randomLevel() int {
+// newLevelGenerator creates a level generator, invalid values fall back to the defaults
+// and a maxLevel above MaxLevelLimit is lowered to the limit.
+func newLevelGenerator(maxLevel int, probability float64, source rand.Source) levelGenerator {
+	if maxLevel < 1 {
+		maxLevel = MaxLevel
+	}
+	maxLevel = min(maxLevel, MaxLevelLimit)
+	// Written this way so that NaN is rejected as well
+	if !(probability > 0 && probability < 1) {
+		probability = Probability
+	}
+
+	levels := levelGenerator{maxLevel: maxLevel, probability: probability}
+	if source != nil {
+		levels.rng = rand.New(source)
+	}
+	return levels
+}
+
+// next generates a random level for a new node
+func (g *levelGenerator) next() int {
 	level := 1
 	// Randomly decide the level of the new node based on probability
-	for rand.Float64() < Probability && level < MaxLevel {
+	for g.float64() < g.probability && level < g.maxLevel {
 		level++
 	}
 	return level
 }
 
+// float64 returns a random number in [0, 1) from the source of the generator
+func (g *levelGenerator) float64() float64 {
+	if g.rng == nil {
+		return rand.Float64()
+	}
+	return g.rng.Float64()
+}
+
 // Add adds a new element to the skip list
+// In a list created with NewUnique, adding an existing member moves it to score instead.
 func (sl *List) Add(score float64, obj interface{}) *Node {
 	// Check if score is valid (not NaN)
 	if math.IsNaN(score) {
 		return nil
 	}
+	if sl.members != nil {
+		if !hashable(obj) {
+			return nil
+		}
+		if node, ok := sl.members[obj]; ok {
+			return sl.UpdateScore(node.score, obj, score)
+		}
+	}
 
 	// Create an array to store the update nodes at each level
 	update := make([]*Node, sl.level)
@@ -113,7 +183,7 @@ func (sl *List) Add(score float64, obj interface{}) *Node {
 	}
 
 	// Randomly determine the level of the new node
-	level := randomLevel()
+	level := sl.levels.next()
 
 	// If the new level is higher than the current maximum level, update the skip list
 	if level > sl.level {
@@ -121,7 +191,6 @@ func (sl *List) Add(score float64, obj interface{}) *Node {
 		for i := sl.level; i < level; i++ {
 			update = append(update, sl.header)
 			rank = append(rank, 0)
-			update[i].level = append(update[i].level, &nodeLevel{}) // Expanding level array for the new level
 			update[i].level[i].span = int(sl.length)
 		}
 		// Update the current maximum level of the skip list
@@ -148,8 +217,10 @@ func (sl *List) Add(score float64, obj interface{}) *Node {
 		update[i].level[i].span++
 	}
 
-	// Set the backward pointer of the new node
-	newListNode.backward = update[0]
+	// Set the backward pointer of the new node, the first node has no predecessor
+	if update[0] != sl.header {
+		newListNode.backward = update[0]
+	}
 	if newListNode.level[0].forward != nil {
 		newListNode.level[0].forward.backward = newListNode
 	} else {
@@ -159,12 +230,27 @@ func (sl *List) Add(score float64, obj interface{}) *Node {
 
 	// Update the length of the skip list
 	sl.length++
+	sl.version++
+	if sl.members != nil {
+		sl.members[obj] = newListNode
+	}
 
 	return newListNode
 }
 
 // Remove removes an element by score and value.
 func (sl *List) Remove(score float64, obj interface{}) bool {
+	if sl.members != nil {
+		node := sl.uniqueNode(score, obj)
+		if node == nil {
+			return false
+		}
+		update, _ := sl.path(node)
+		sl.removeNode(node, update)
+		sl.freeNode(node)
+		return true
+	}
+
 	update := make([]*Node, sl.level)
 	current := sl.header
 
@@ -195,6 +281,71 @@ func (sl *List) Remove(score float64, obj interface{}) bool {
 	return false
 }
 
+// UpdateScore moves the element obj from oldScore to newScore and returns its node
+// The node is updated in place when the new score keeps it between its neighbors,
+// otherwise it is removed and added again. Return nil if the element is not found or newScore is NaN.
+func (sl *List) UpdateScore(oldScore float64, obj interface{}, newScore float64) *Node {
+	if math.IsNaN(newScore) {
+		return nil
+	}
+
+	var x *Node
+	var update []*Node
+	if sl.members != nil {
+		if x = sl.uniqueNode(oldScore, obj); x == nil {
+			return nil
+		}
+		update, _ = sl.path(x)
+	} else {
+		update = make([]*Node, sl.level)
+		x = sl.header
+		for i := sl.level - 1; i >= 0; i-- {
+			for x.level[i].forward != nil &&
+				(x.level[i].forward.score < oldScore ||
+					(x.level[i].forward.score == oldScore && utils.CompareObjects(x.level[i].forward.obj, obj) < 0)) {
+				x = x.level[i].forward
+			}
+			update[i] = x
+		}
+
+		x = x.level[0].forward
+		if x == nil || x.score != oldScore || !utils.EqualObjects(x.obj, obj) {
+			return nil
+		}
+	}
+
+	// The order does not change if the node still sorts after its predecessor and before its successor
+	prev, next := x.backward, x.level[0].forward
+	if (prev == nil || prev.score < newScore || (prev.score == newScore && utils.CompareObjects(prev.obj, obj) < 0)) &&
+		(next == nil || next.score > newScore || (next.score == newScore && utils.CompareObjects(next.obj, obj) > 0)) {
+		x.score = newScore
+		sl.version++
+		return x
+	}
+
+	// Otherwise move the element to its new position
+	sl.removeNode(x, update)
+	sl.freeNode(x)
+	return sl.Add(newScore, obj)
+}
+
+// GetByMember returns the node holding obj, nil if not found
+// It takes O(1) in a list created with NewUnique, otherwise it scans the list and returns the first match.
+func (sl *List) GetByMember(obj interface{}) *Node {
+	if sl.members != nil {
+		if !hashable(obj) {
+			return nil
+		}
+		return sl.members[obj]
+	}
+	for x := sl.header.level[0].forward; x != nil; x = x.level[0].forward {
+		if utils.EqualObjects(x.obj, obj) {
+			return x
+		}
+	}
+	return nil
+}
+
 func (sl *List) removeNode(current *Node, update []*Node) {
 	// Traverse from the highest level to the lowest level
 	for i := 0; i < sl.level; i++ {
@@ -224,6 +375,55 @@ func (sl *List) removeNode(current *Node, update []*Node) {
 
 	// Decrease the length of the skip list
 	sl.length--
+	sl.version++
+	if sl.members != nil {
+		delete(sl.members, current.obj)
+	}
+}
+
+// uniqueNode returns the node of obj in a list created with NewUnique, nil if obj is absent or has another score
+func (sl *List) uniqueNode(score float64, obj interface{}) *Node {
+	if !hashable(obj) {
+		return nil
+	}
+	if node := sl.members[obj]; node != nil && node.score == score {
+		return node
+	}
+	return nil
+}
+
+// path returns the predecessors of a node of the list at every level and its 1-based rank
+// It finds the node by identity, among the nodes that utils.CompareObjects orders as equal to it.
+func (sl *List) path(node *Node) ([]*Node, int) {
+	update := make([]*Node, sl.level)
+	rank := 0
+	x := sl.header
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil &&
+			(x.level[i].forward.score < node.score ||
+				(x.level[i].forward.score == node.score && utils.CompareObjects(x.level[i].forward.obj, node.obj) < 0)) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+
+	// Step over the nodes that sort as equal to node but are not node
+	for x.level[0].forward != node {
+		x = x.level[0].forward
+		rank++
+		for i := range x.level {
+			update[i] = x
+		}
+	}
+	return update, rank + 1
+}
+
+// hashable checks if obj can be used as a key of the members map
+// The value is checked rather than its type, since a struct with an interface field is a comparable type
+// but panics as a map key when the field holds a slice or a map.
+func hashable(obj interface{}) bool {
+	return obj == nil || reflect.ValueOf(obj).Comparable()
 }
 
 func (sl *List) freeNode(current *Node) {
@@ -235,6 +435,9 @@ func (sl *List) freeNode(current *Node) {
 // Find finds an element by score and value
 // Return nil if not found
 func (sl *List) Find(score float64, obj interface{}) *Node {
+	if sl.members != nil {
+		return sl.uniqueNode(score, obj)
+	}
 	current := sl.header
 
 	// Iterate from the top level downwards
@@ -265,7 +468,17 @@ func (sl *List) Contains(score float64, obj interface{}) bool {
 
 // Rank calculates the rank of the given score and object in the skip list
 // It returns the rank (index) of the element if found, otherwise -1
+// Both the score and the object must match, a member stored with another score is not found.
 func (sl *List) Rank(score float64, obj interface{}) int {
+	if sl.members != nil {
+		node := sl.uniqueNode(score, obj)
+		if node == nil {
+			return -1
+		}
+		_, rank := sl.path(node)
+		return rank
+	}
+
 	var rank int
 	x := sl.header
 
@@ -283,7 +496,7 @@ func (sl *List) Rank(score float64, obj interface{}) int {
 		}
 
 		// If the current node is equal to the target node, return the accumulated rank
-		if x.obj != nil && utils.CompareObjects(x.obj, obj) == 0 {
+		if x != sl.header && x.score == score && utils.CompareObjects(x.obj, obj) == 0 {
 			return rank
 		}
 	}
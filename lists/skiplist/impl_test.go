@@ -5,6 +5,9 @@
 package skiplist
 
 import (
+	"math"
+	"math/rand"
+	"reflect"
 	"testing"
 )
 
@@ -198,6 +201,15 @@ func TestList_Rank(t *testing.T) {
 	if rank != -1 {
 		t.Errorf("Expected rank -1, got %d", rank)
 	}
+
+	// The score must match as well, not only the object
+	skipList.Add(5.0, "one")
+	if rank := skipList.Rank(3.0, "one"); rank != -1 {
+		t.Errorf("Expected rank -1 for a wrong score, got %d", rank)
+	}
+	if rank := skipList.Rank(5.0, "one"); rank != 4 {
+		t.Errorf("Expected rank 4 for the duplicate member, got %d", rank)
+	}
 }
 
 func TestList_GetByRank(t *testing.T) {
@@ -325,3 +337,291 @@ func TestList_String(t *testing.T) {
 		t.Fatalf("Expected %s, got %s", expected, result)
 	}
 }
+
+// nodeLevels returns the number of levels of every node, in order
+func nodeLevels(sl *List) []int {
+	var levels []int
+	for x := sl.header.level[0].forward; x != nil; x = x.level[0].forward {
+		levels = append(levels, len(x.level))
+	}
+	return levels
+}
+
+func TestNewWithOptions_Defaults(t *testing.T) {
+	tests := []struct {
+		maxLevel    int
+		probability float64
+	}{
+		{0, 0},
+		{-1, 1},
+		{-100, -0.5},
+		{MaxLevel, math.NaN()},
+	}
+
+	for _, tt := range tests {
+		sl := NewWithOptions(tt.maxLevel, tt.probability, nil)
+		if sl.levels.maxLevel != MaxLevel || sl.levels.probability != Probability || sl.levels.rng != nil {
+			t.Errorf("NewWithOptions(%d, %v, nil): expected the defaults, got %+v", tt.maxLevel, tt.probability, sl.levels)
+		}
+		if len(sl.header.level) != MaxLevel {
+			t.Errorf("Expected the header to have %d levels, got %d", MaxLevel, len(sl.header.level))
+		}
+	}
+}
+
+func TestNewWithOptions_MaxLevelLimit(t *testing.T) {
+	// Asking for more levels than the limit gives the limit, not the smaller default
+	for _, maxLevel := range []int{MaxLevelLimit, MaxLevelLimit + 1, 100} {
+		sl := NewWithOptions(maxLevel, Probability, nil)
+		if sl.levels.maxLevel != MaxLevelLimit || len(sl.header.level) != MaxLevelLimit {
+			t.Errorf("NewWithOptions(%d): expected %d levels, got %d", maxLevel, MaxLevelLimit, sl.levels.maxLevel)
+		}
+	}
+}
+
+func TestNewWithOptions_Reproducible(t *testing.T) {
+	build := func() *List {
+		sl := NewWithOptions(8, 0.3, rand.NewSource(42))
+		for i := 0; i < 500; i++ {
+			sl.Add(float64(i), i)
+		}
+		return sl
+	}
+
+	// The same seed gives the same layout
+	first, second := build(), build()
+	if !reflect.DeepEqual(nodeLevels(first), nodeLevels(second)) {
+		t.Errorf("Expected two lists built from the same seed to have the same layout")
+	}
+
+	// And the layout follows the documented distribution, drawn from the given source
+	r := rand.New(rand.NewSource(42))
+	for i, level := range nodeLevels(first) {
+		expected := 1
+		for r.Float64() < 0.3 && expected < 8 {
+			expected++
+		}
+		if level != expected {
+			t.Fatalf("Node %d: expected level %d, got %d", i, expected, level)
+		}
+	}
+}
+
+func TestNewWithOptions_LargeList(t *testing.T) {
+	sl := NewWithOptions(32, 0.25, rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		sl.Add(float64(i), i)
+	}
+
+	if len(sl.header.level) != 32 || sl.level > 32 {
+		t.Errorf("Expected at most 32 levels, got a header of %d and level %d", len(sl.header.level), sl.level)
+	}
+	for _, rank := range []int{1, 777, 65536, 65537, 99999, 100000} {
+		if node := sl.GetByRank(rank); node == nil || node.obj != rank-1 {
+			t.Errorf("Expected %d at rank %d, got %v", rank-1, rank, node)
+		}
+	}
+
+	// Unmarshaling keeps the configured distribution
+	if err := sl.UnmarshalJSON([]byte(`[{"score":1,"member":"a"}]`)); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if sl.levels.maxLevel != 32 || sl.levels.probability != 0.25 || len(sl.header.level) != 32 {
+		t.Errorf("Expected the options to survive unmarshaling, got %+v", sl.levels)
+	}
+}
+
+func TestList_HeaderLevels(t *testing.T) {
+	sl := New()
+	for i := 0; i < 1000; i++ {
+		sl.Add(float64(i), i)
+	}
+
+	// The header is allocated with every level up front and never grows
+	if len(sl.header.level) != MaxLevel {
+		t.Errorf("Expected the header to keep %d levels, got %d", MaxLevel, len(sl.header.level))
+	}
+}
+
+func TestList_UpdateScore(t *testing.T) {
+	sl := newRangeTestList()
+
+	// Staying between its neighbors keeps the node in place
+	node := sl.GetByRank(3)
+	it := sl.Iterator()
+	it.Next()
+	if got := sl.UpdateScore(3, "c", 2.5); got != node || node.score != 2.5 {
+		t.Errorf("Expected c to be updated in place, got %v", got)
+	}
+	if it.Next(); it.Err() != ErrIteratorInvalidated {
+		t.Errorf("Expected an in-place update to invalidate iterators")
+	}
+	checkRanks(t, sl, []interface{}{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"})
+
+	// Crossing a neighbor moves the node
+	if got := sl.UpdateScore(2.5, "c", 8.5); got == nil || got.score != 8.5 {
+		t.Errorf("Expected c to move to 8.5, got %v", got)
+	}
+	checkRanks(t, sl, []interface{}{"a", "b", "d", "e", "f", "g", "h", "c", "i", "j"})
+	if got := sl.UpdateScore(1, "a", 100); got == nil || sl.tail != got || sl.GetByRank(1).backward != nil {
+		t.Errorf("Expected a to become the tail, got %v", got)
+	}
+	checkRanks(t, sl, []interface{}{"b", "d", "e", "f", "g", "h", "c", "i", "j", "a"})
+
+	// Equal scores are ordered by member
+	if got := sl.UpdateScore(4, "d", 2); got == nil || sl.Rank(2, "d") != 2 {
+		t.Errorf("Expected d right after b, got rank %d", sl.Rank(2, "d"))
+	}
+
+	if sl.UpdateScore(4, "d", 1) != nil || sl.UpdateScore(5, "e", math.NaN()) != nil {
+		t.Errorf("Expected missing elements and NaN scores to be rejected")
+	}
+	if sl.Size() != 10 {
+		t.Errorf("Expected size 10, got %d", sl.Size())
+	}
+}
+
+func TestNewUnique(t *testing.T) {
+	sl := NewUnique()
+	sl.Add(1, "a")
+	sl.Add(2, "b")
+	sl.Add(3, "c")
+
+	// Adding an existing member moves it instead of duplicating it
+	if node := sl.Add(10, "a"); node == nil || node.score != 10 {
+		t.Errorf("Expected a to move to 10, got %v", node)
+	}
+	if sl.Size() != 3 || sl.Rank(1, "a") != -1 || sl.Rank(10, "a") != 3 {
+		t.Errorf("Expected a single a at rank 3, got %v", sl)
+	}
+	if node := sl.GetByMember("a"); node == nil || node.score != 10 {
+		t.Errorf("Expected GetByMember to find a at 10, got %v", node)
+	}
+
+	sl.Remove(2, "b")
+	sl.PopMin(1)
+	if sl.GetByMember("b") != nil || sl.GetByMember("c") != nil || len(sl.members) != 1 {
+		t.Errorf("Expected removed members to be forgotten, got %v", sl.members)
+	}
+	if node := sl.Add(5, "b"); node == nil || sl.Size() != 2 {
+		t.Errorf("Expected b to be added again, got %v", sl)
+	}
+
+	if err := sl.BulkLoad([]Element{{1, "x"}, {2, "x"}}); err != ErrDuplicateMember {
+		t.Errorf("Expected ErrDuplicateMember, got %v", err)
+	}
+	if err := sl.BulkLoad([]Element{{1, "x"}, {2, "y"}}); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	sl.Add(3, "x")
+	if sl.Size() != 2 || sl.GetByMember("x").score != 3 || sl.GetByMember("a") != nil {
+		t.Errorf("Expected a bulk loaded list to stay unique, got %v", sl)
+	}
+}
+
+func TestList_GetByMember(t *testing.T) {
+	sl := New()
+	sl.Add(2, "a")
+	sl.Add(1, "a")
+
+	// Without unique members the first match is returned
+	if node := sl.GetByMember("a"); node == nil || node.score != 1 {
+		t.Errorf("Expected a at 1, got %v", node)
+	}
+	if sl.GetByMember("b") != nil {
+		t.Errorf("Expected nil for a missing member")
+	}
+}
+
+func TestList_AddUnhashableMember(t *testing.T) {
+	// Lists without unique members accept any member, including slices
+	sl := New()
+	if sl.Add(1, []byte("abc")) == nil || sl.Add(2, []int{1}) == nil {
+		t.Fatalf("Expected slice members to be added")
+	}
+	if sl.Size() != 2 || !sl.Contains(1, []byte("abc")) {
+		t.Errorf("Expected the slice members to be found, got %v", sl)
+	}
+	if !sl.Remove(2, []int{1}) || sl.Size() != 1 {
+		t.Errorf("Expected the slice member to be removed, got %v", sl)
+	}
+}
+
+func TestNewUnique_MemberEquality(t *testing.T) {
+	// Members are matched with ==, even where utils.CompareObjects sees no difference
+	sl := NewUnique()
+	one := sl.Add(1, 1)
+	oneString := sl.Add(1, "1")
+	sl.Add(0, "a")
+	sl.Add(2, "b")
+	if sl.Size() != 4 || one == oneString {
+		t.Fatalf("Expected 1 and \"1\" to be two members, got %v", sl)
+	}
+
+	if sl.Find(1, "1") != oneString || sl.Find(1, 1) != one || sl.Find(1, 1.0) != nil {
+		t.Errorf("Expected Find to match members with ==")
+	}
+	// Both sit between a and b, in an order decided by utils.CompareObjects
+	if r1, r2 := sl.Rank(1, 1), sl.Rank(1, "1"); r1 == r2 || min(r1, r2) != 2 || max(r1, r2) != 3 {
+		t.Errorf("Expected ranks 2 and 3, got %d and %d", r1, r2)
+	}
+	if sl.Rank(2, "1") != -1 || sl.Contains(2, 1) {
+		t.Errorf("Expected a member stored with another score not to be found")
+	}
+
+	// Removing "1" leaves 1 in place
+	if !sl.Remove(1, "1") || sl.Remove(1, "1") {
+		t.Errorf("Expected \"1\" to be removed once")
+	}
+	if sl.GetByMember(1) != one || sl.GetByMember("1") != nil || sl.Rank(1, 1) != 2 {
+		t.Errorf("Expected 1 to be kept, got %v", sl)
+	}
+	if got := sl.UpdateScore(1, 1, 3); got == nil || sl.Rank(3, 1) != 3 || sl.GetByMember(1).score != 3 {
+		t.Errorf("Expected 1 to move to 3, got %v", sl)
+	}
+	checkSpans(t, sl)
+}
+
+func TestNewUnique_UnhashableMember(t *testing.T) {
+	sl := NewUnique()
+	sl.Add(1, "a")
+	if sl.Add(2, []byte("abc")) != nil || sl.Size() != 1 {
+		t.Errorf("Expected a slice member to be rejected, got %v", sl)
+	}
+	if sl.GetByMember([]byte("abc")) != nil || sl.Contains(2, []byte("abc")) || sl.Remove(2, []byte("abc")) {
+		t.Errorf("Expected lookups of a slice member to find nothing")
+	}
+	if sl.Rank(2, []int{1}) != -1 || sl.UpdateScore(2, []int{1}, 3) != nil {
+		t.Errorf("Expected Rank and UpdateScore to find nothing")
+	}
+	if err := sl.BulkLoad([]Element{{1, []int{1}}}); err != ErrUnhashableMember || sl.Size() != 1 {
+		t.Errorf("Expected ErrUnhashableMember, got %v", err)
+	}
+
+	// A struct type is comparable, but not when one of its interface fields holds a slice
+	type wrapper struct{ v interface{} }
+	if sl.Add(3, wrapper{[]int{1}}) != nil || sl.Contains(3, wrapper{[]int{1}}) || sl.Size() != 1 {
+		t.Errorf("Expected a struct holding a slice to be rejected, got %v", sl)
+	}
+	if sl.Add(3, wrapper{1}) == nil || !sl.Contains(3, wrapper{1}) {
+		t.Errorf("Expected a struct holding an int to be accepted")
+	}
+}
+
+func TestNewUniqueWithOptions(t *testing.T) {
+	sl := NewUniqueWithOptions(8, 0.25, rand.NewSource(1))
+	if sl.levels.maxLevel != 8 || sl.levels.probability != 0.25 || sl.members == nil {
+		t.Fatalf("Expected a unique list with the given options, got %+v", sl.levels)
+	}
+
+	sl.Add(1, "a")
+	sl.Add(2, "a")
+	if sl.Size() != 1 || !sl.Contains(2, "a") {
+		t.Errorf("Expected adding an existing member to move it, got %v", sl)
+	}
+
+	// Invalid options fall back to the defaults like in NewWithOptions
+	if sl := NewUniqueWithOptions(0, 2, nil); sl.levels.maxLevel != MaxLevel || sl.levels.probability != Probability {
+		t.Errorf("Expected the defaults, got %+v", sl.levels)
+	}
+}
@@ -4,6 +4,13 @@
 
 package skiplist
 
+import (
+	"cmp"
+	"context"
+	"io"
+	"time"
+)
+
 // SkipList defines the interface for a skip list
 type SkipList interface {
 	Add(score float64, value interface{}) *Node     // Adds an element with a given score and value
@@ -12,7 +19,95 @@ type SkipList interface {
 	Contains(score float64, value interface{}) bool // Checks whether an element with the given score and object exists in the skip list
 	Rank(score float64, value interface{}) int      // Gets the rank of an element (1-based index). Return -1 if not found
 	GetByRank(rank int) *Node                       // Retrieves an element by its rank (1-based index)
+	GetByMember(value interface{}) *Node            // Retrieves the node holding a member, O(1) with unique members
 	Size() int                                      // Returns the number of elements in the skip list
 	IsEmpty() bool                                  // Checks if the skip list is empty
 	String() string                                 // Returns a string representation of the skip list
+	Iterator() *Iterator                            // Returns an iterator positioned before the first node
+
+	UpdateScore(oldScore float64, value interface{}, newScore float64) *Node // Moves an element to a new score, in place when its order does not change
+
+	RangeByScore(r ScoreRange, offset, limit int) []*Node    // Returns the nodes within a score range, ascending
+	RevRangeByScore(r ScoreRange, offset, limit int) []*Node // Returns the nodes within a score range, descending
+	RangeByRank(start, stop int) []*Node                     // Returns the nodes between two 0-based ranks, ascending
+	RevRangeByRank(start, stop int) []*Node                  // Returns the nodes between two 0-based ranks, descending
+	CountInScore(r ScoreRange) int                           // Returns the number of nodes within a score range
+	RangeByLex(r LexRange, offset, limit int) []*Node        // Returns the nodes within a lexicographic range, ascending
+	RevRangeByLex(r LexRange, offset, limit int) []*Node     // Returns the nodes within a lexicographic range, descending
+	CountByLex(r LexRange) int                               // Returns the number of nodes within a lexicographic range
+
+	RemoveRangeByScore(r ScoreRange, fn func(node *Node)) int   // Removes the nodes within a score range
+	RemoveRangeByRank(start, stop int, fn func(node *Node)) int // Removes the nodes between two 0-based ranks
+	RemoveRangeByLex(r LexRange, fn func(node *Node)) int       // Removes the nodes within a lexicographic range
+	PopMin(n int) []Element                                     // Removes and returns the n nodes with the lowest scores
+	PopMax(n int) []Element                                     // Removes and returns the n nodes with the highest scores
+
+	WriteTo(w io.Writer) (int64, error)  // Streams a snapshot of the skip list
+	ReadFrom(r io.Reader) (int64, error) // Replaces the content of the skip list with a snapshot
+	BulkLoad(elements []Element) error   // Replaces the content of the skip list with sorted elements in O(n)
+}
+
+// ZSet defines the interface for a Redis-style sorted set
+type ZSet interface {
+	ZAdd(flags ZAddFlag, elements ...Element) (int, error)          // Adds members or updates their scores
+	ZIncrBy(increment float64, member interface{}) (float64, error) // Increments the score of a member
+	ZRem(members ...interface{}) int                                // Removes members, returns how many were removed
+	ZRemRangeByScore(r ScoreRange) int                              // Removes the members within a score range
+	ZRemRangeByRank(start, stop int) int                            // Removes the members between two 0-based ranks
+	ZRemRangeByLex(r LexRange) int                                  // Removes the members within a lexicographic range
+	ZPopMin(count int) []Element                                    // Removes and returns the members with the lowest scores
+	ZPopMax(count int) []Element                                    // Removes and returns the members with the highest scores
+	ZScore(member interface{}) (float64, bool)                      // Returns the score of a member
+	ZRank(member interface{}) int                                   // Returns the 0-based rank by ascending score, -1 if not found
+	ZRevRank(member interface{}) int                                // Returns the 0-based rank by descending score, -1 if not found
+	ZRangeByRank(start, stop int) []Element                         // Returns the members between two 0-based ranks, ascending
+	ZRevRangeByRank(start, stop int) []Element                      // Returns the members between two 0-based ranks, descending
+	ZRangeByScore(r ScoreRange, offset, limit int) []Element        // Returns the members within a score range, ascending
+	ZRevRangeByScore(r ScoreRange, offset, limit int) []Element     // Returns the members within a score range, descending
+	ZRangeByLex(r LexRange, offset, limit int) []Element            // Returns the members within a lexicographic range, ascending
+	ZRevRangeByLex(r LexRange, offset, limit int) []Element         // Returns the members within a lexicographic range, descending
+	ZCount(r ScoreRange) int                                        // Returns the number of members within a score range
+	ZLexCount(r LexRange) int                                       // Returns the number of members within a lexicographic range
+	ZCard() int                                                     // Returns the number of members
+	String() string                                                 // Returns a string representation of the sorted set
+}
+
+// BlockingSkipList defines the interface for a goroutine-safe skip list with blocking pops
+type BlockingSkipList interface {
+	Add(score float64, obj interface{}) bool                                    // Adds an element and wakes up a waiting consumer
+	Remove(score float64, obj interface{}) bool                                 // Removes an element by score and value
+	Contains(score float64, obj interface{}) bool                               // Checks if an element exists in the skip list
+	PopMin(n int) []Element                                                     // Removes and returns the n elements with the lowest scores
+	PopMax(n int) []Element                                                     // Removes and returns the n elements with the highest scores
+	BlockingPopMin(ctx context.Context, timeout time.Duration) (Element, error) // Waits for and pops the element with the lowest score
+	BlockingPopMax(ctx context.Context, timeout time.Duration) (Element, error) // Waits for and pops the element with the highest score
+	Size() int                                                                  // Returns the number of elements in the skip list
+	IsEmpty() bool                                                              // Checks if the skip list is empty
+	String() string                                                             // Returns a string representation of the skip list
+}
+
+// GenericSkipList defines the interface for a skip list with typed scores and members
+type GenericSkipList[S cmp.Ordered, M any] interface {
+	Add(score S, member M) *GenericNode[S, M]     // Adds a new element to the skip list
+	Remove(score S, member M) bool                // Removes an element from the skip list
+	Find(score S, member M) *GenericNode[S, M]    // Finds an element by score and member
+	Ceiling(score S, member M) *GenericNode[S, M] // Returns the first node not less than score/member
+	Contains(score S, member M) bool              // Checks if an element exists in the skip list
+	Rank(score S, member M) int                   // Returns the 1-based rank of an element, -1 if not found
+	GetByRank(rank int) *GenericNode[S, M]        // Returns the node at the 1-based rank
+	First() *GenericNode[S, M]                    // Returns the node with the lowest score
+	Last() *GenericNode[S, M]                     // Returns the node with the highest score
+	Each(fn func(score S, member M) bool)         // Calls fn for every element in ascending order
+	Size() int                                    // Returns the number of elements in the skip list
+	IsEmpty() bool                                // Checks if the skip list is empty
+	String() string                               // Returns a string representation of the skip list
+
+	RangeByScore(r GenericScoreRange[S], offset, limit int) []*GenericNode[S, M]    // Returns the nodes within a score range, ascending
+	RevRangeByScore(r GenericScoreRange[S], offset, limit int) []*GenericNode[S, M] // Returns the nodes within a score range, descending
+	RangeByRank(start, stop int) []*GenericNode[S, M]                               // Returns the nodes between two 0-based ranks, ascending
+	RevRangeByRank(start, stop int) []*GenericNode[S, M]                            // Returns the nodes between two 0-based ranks, descending
+	CountInScore(r GenericScoreRange[S]) int                                        // Returns the number of nodes within a score range
+
+	RemoveRangeByScore(r GenericScoreRange[S], fn func(node *GenericNode[S, M])) int // Removes the nodes within a score range
+	RemoveRangeByRank(start, stop int, fn func(node *GenericNode[S, M])) int         // Removes the nodes between two 0-based ranks
 }
@@ -0,0 +1,127 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import "math"
+
+// Next returns the node that follows n, or nil if n is the last node
+// The result is undefined if n was removed from its list.
+func (n *Node) Next() *Node {
+	return n.level[0].forward
+}
+
+// Prev returns the node that precedes n, or nil if n is the first node
+// The result is undefined if n was removed from its list.
+func (n *Node) Prev() *Node {
+	return n.backward
+}
+
+// Iterator returns an iterator positioned before the first node of the list
+//
+// Any change of the list (Add, Remove, range removals, unmarshaling, ...) invalidates the iterator:
+// Next and Prev then return false and Err returns ErrIteratorInvalidated.
+// Seek, SeekToFirst and SeekToLast do not depend on the current position,
+// so they reposition an invalidated iterator on the current content of the list.
+func (sl *List) Iterator() *Iterator {
+	return &Iterator{list: sl, version: sl.version}
+}
+
+// Next moves to the next node and reports whether there is one
+// On a fresh iterator, it moves to the first node.
+func (it *Iterator) Next() bool {
+	if !it.check() {
+		return false
+	}
+
+	switch {
+	case it.node != nil:
+		it.node = it.node.level[0].forward
+	case !it.atEnd:
+		it.node = it.list.header.level[0].forward
+	}
+	it.atEnd = it.node == nil
+	return it.node != nil
+}
+
+// Prev moves to the previous node and reports whether there is one
+// Once past the last node, it moves back to the last node.
+func (it *Iterator) Prev() bool {
+	if !it.check() {
+		return false
+	}
+
+	switch {
+	case it.node != nil:
+		it.node = it.node.backward
+	case it.atEnd:
+		it.node = it.list.tail
+	}
+	it.atEnd = false
+	return it.node != nil
+}
+
+// Seek moves to the first node whose score is greater than or equal to score
+// It reports whether there is such a node, otherwise the iterator is past the last node.
+func (it *Iterator) Seek(score float64) bool {
+	x, _ := it.list.firstInScoreRange(ScoreRange{Min: score, Max: math.Inf(1)})
+	return it.reset(x)
+}
+
+// SeekToFirst moves to the first node and reports whether the list is not empty
+func (it *Iterator) SeekToFirst() bool {
+	return it.reset(it.list.header.level[0].forward)
+}
+
+// SeekToLast moves to the last node and reports whether the list is not empty
+func (it *Iterator) SeekToLast() bool {
+	return it.reset(it.list.tail)
+}
+
+// Valid checks if the iterator is positioned on a node
+func (it *Iterator) Valid() bool {
+	return it.err == nil && it.node != nil && it.version == it.list.version
+}
+
+// Node returns the current node, or nil if the iterator is not valid
+func (it *Iterator) Node() *Node {
+	if !it.Valid() {
+		return nil
+	}
+	return it.node
+}
+
+// Score returns the score of the current node
+// It must only be called when Valid returns true
+func (it *Iterator) Score() float64 {
+	return it.Node().score
+}
+
+// Obj returns the object of the current node
+// It must only be called when Valid returns true
+func (it *Iterator) Obj() interface{} {
+	return it.Node().obj
+}
+
+// Err returns ErrIteratorInvalidated if the list changed since the iterator was positioned
+func (it *Iterator) Err() error {
+	it.check()
+	return it.err
+}
+
+// check records the invalidation of the iterator and reports whether it can still move
+func (it *Iterator) check() bool {
+	if it.err == nil && it.version != it.list.version {
+		it.err = ErrIteratorInvalidated
+		it.node = nil
+	}
+	return it.err == nil
+}
+
+// reset positions the iterator on x for the current version of the list
+func (it *Iterator) reset(x *Node) bool {
+	it.node, it.atEnd = x, x == nil
+	it.version, it.err = it.list.version, nil
+	return x != nil
+}
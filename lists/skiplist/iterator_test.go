@@ -0,0 +1,156 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNode_NextPrev(t *testing.T) {
+	sl := newRangeTestList()
+
+	var forward []interface{}
+	for x := sl.GetByRank(1); x != nil; x = x.Next() {
+		forward = append(forward, x.GetObj())
+	}
+	var backward []interface{}
+	for x := sl.GetByRank(10); x != nil; x = x.Prev() {
+		backward = append(backward, x.GetObj())
+	}
+
+	if !reflect.DeepEqual(forward, []interface{}{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}) {
+		t.Errorf("Unexpected forward walk %v", forward)
+	}
+	if !reflect.DeepEqual(backward, []interface{}{"j", "i", "h", "g", "f", "e", "d", "c", "b", "a"}) {
+		t.Errorf("Unexpected backward walk %v", backward)
+	}
+}
+
+func TestIterator_NextPrev(t *testing.T) {
+	sl := New()
+	sl.Add(1, "a")
+	sl.Add(2, "b")
+	sl.Add(3, "c")
+
+	it := sl.Iterator()
+	if it.Valid() || it.Node() != nil {
+		t.Errorf("Expected a fresh iterator not to be positioned on a node")
+	}
+
+	var objs []interface{}
+	for it.Next() {
+		objs = append(objs, it.Obj())
+	}
+	if !reflect.DeepEqual(objs, []interface{}{"a", "b", "c"}) {
+		t.Errorf("Expected [a b c], got %v", objs)
+	}
+	if it.Next() || it.Valid() {
+		t.Errorf("Expected the iterator to stay past the last node")
+	}
+
+	// Going back from past the end starts at the last node
+	objs = nil
+	for it.Prev() {
+		objs = append(objs, it.Obj())
+	}
+	if !reflect.DeepEqual(objs, []interface{}{"c", "b", "a"}) {
+		t.Errorf("Expected [c b a], got %v", objs)
+	}
+	if it.Err() != nil {
+		t.Errorf("Expected no error, got %v", it.Err())
+	}
+
+	if New().Iterator().Next() {
+		t.Errorf("Expected an empty list to have nothing to iterate")
+	}
+}
+
+func TestIterator_Seek(t *testing.T) {
+	sl := newRangeTestList()
+	sl.Add(5, "e2")
+	it := sl.Iterator()
+
+	tests := []struct {
+		score    float64
+		expected interface{}
+	}{
+		{5, "e"},
+		{4.5, "e"},
+		{-100, "a"},
+		{10, "j"},
+	}
+	for _, tt := range tests {
+		if !it.Seek(tt.score) || it.Obj() != tt.expected {
+			t.Errorf("Seek(%v): expected %v, got %v", tt.score, tt.expected, it.Node())
+		}
+	}
+
+	if it.Seek(10.5) || it.Valid() {
+		t.Errorf("Expected Seek above the highest score to leave the iterator past the last node")
+	}
+	if !it.Prev() || it.Obj() != "j" {
+		t.Errorf("Expected Prev after a failed Seek to move to the last node")
+	}
+
+	if !it.SeekToFirst() || it.Score() != 1 {
+		t.Errorf("Expected SeekToFirst to move to the first node")
+	}
+	if it.Prev() {
+		t.Errorf("Expected nothing before the first node")
+	}
+	if !it.SeekToLast() || it.Score() != 10 {
+		t.Errorf("Expected SeekToLast to move to the last node")
+	}
+	if !it.Prev() || it.Obj() != "i" {
+		t.Errorf("Expected Prev from the last node to move to i, got %v", it.Node())
+	}
+}
+
+func TestIterator_Invalidation(t *testing.T) {
+	mutations := []struct {
+		name   string
+		mutate func(sl *List)
+	}{
+		{"Add", func(sl *List) { sl.Add(11, "k") }},
+		{"Remove", func(sl *List) { sl.Remove(1, "a") }},
+		{"RemoveRangeByScore", func(sl *List) { sl.RemoveRangeByScore(ScoreRange{Min: 5, Max: 6}, nil) }},
+		{"UnmarshalJSON", func(sl *List) { _ = sl.UnmarshalJSON([]byte(`[{"score":1,"member":"a"}]`)) }},
+	}
+
+	for _, m := range mutations {
+		name, sl := m.name, newRangeTestList()
+		it := sl.Iterator()
+		if !it.SeekToFirst() {
+			t.Fatalf("%s: expected a non-empty list", name)
+		}
+
+		m.mutate(sl)
+		if it.Valid() || it.Node() != nil {
+			t.Errorf("%s: expected the iterator to be invalidated", name)
+		}
+		if it.Next() || it.Prev() {
+			t.Errorf("%s: expected an invalidated iterator not to move", name)
+		}
+		if it.Err() != ErrIteratorInvalidated {
+			t.Errorf("%s: expected ErrIteratorInvalidated, got %v", name, it.Err())
+		}
+
+		// Seeking repositions the iterator on the new content
+		if !it.SeekToFirst() || it.Err() != nil {
+			t.Errorf("%s: expected SeekToFirst to make the iterator usable again", name)
+		}
+	}
+
+	// Operations that change nothing keep iterators valid
+	sl := newRangeTestList()
+	it := sl.Iterator()
+	it.SeekToFirst()
+	sl.Remove(42, "missing")
+	sl.RemoveRangeByRank(5, 4, nil)
+	if !it.Valid() || it.Err() != nil {
+		t.Errorf("Expected failed removals not to invalidate the iterator")
+	}
+}
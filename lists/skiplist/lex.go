@@ -0,0 +1,170 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"strings"
+
+	"github.com/ethan-gao-code/go-ds/utils"
+)
+
+// RangeByLex returns the nodes whose member is within r, in ascending order
+// offset and limit behave as in RangeByScore. Like Redis ZRANGEBYLEX,
+// the result is only meaningful when all nodes share the same score.
+func (sl *List) RangeByLex(r LexRange, offset, limit int) []*Node {
+	x, rank := sl.firstInLexRange(r)
+	if x == nil || offset < 0 {
+		return nil
+	}
+
+	if offset > 0 {
+		x = sl.GetByRank(rank + offset)
+	}
+
+	var nodes []*Node
+	for ; x != nil && limit != 0 && r.lteMax(x.obj); x = x.level[0].forward {
+		nodes = append(nodes, x)
+		limit--
+	}
+	return nodes
+}
+
+// RevRangeByLex returns the nodes whose member is within r, in descending order
+// offset and limit behave as in RangeByLex, counting from the highest member.
+func (sl *List) RevRangeByLex(r LexRange, offset, limit int) []*Node {
+	x, rank := sl.lastInLexRange(r)
+	if x == nil || offset < 0 {
+		return nil
+	}
+
+	if offset > 0 {
+		x = sl.GetByRank(rank - offset)
+	}
+
+	var nodes []*Node
+	for ; x != nil && limit != 0 && r.gteMin(x.obj); x = x.backward {
+		nodes = append(nodes, x)
+		limit--
+	}
+	return nodes
+}
+
+// CountByLex returns the number of nodes whose member is within r, in O(log n)
+func (sl *List) CountByLex(r LexRange) int {
+	if r.isEmpty() {
+		return 0
+	}
+	return sl.countWhile(func(node *Node) bool { return r.lteMax(node.obj) }) -
+		sl.countWhile(func(node *Node) bool { return !r.gteMin(node.obj) })
+}
+
+// ParseLexRange parses a pair of bounds written with the Redis syntax
+// "[a" includes a, "(a" excludes a, "-" and "+" are the lowest and the highest possible members.
+func ParseLexRange(min, max string) (LexRange, error) {
+	minBound, err := parseLexBound(min)
+	if err != nil {
+		return LexRange{}, err
+	}
+	maxBound, err := parseLexBound(max)
+	if err != nil {
+		return LexRange{}, err
+	}
+
+	// Nothing is above "+" or below "-", return a range that no member can satisfy
+	if min == "+" || max == "-" {
+		return LexRange{Min: LexBound{Value: "", Exclusive: true}, Max: LexBound{Value: "", Exclusive: true}}, nil
+	}
+	return LexRange{Min: minBound, Max: maxBound}, nil
+}
+
+// parseLexBound parses a single bound written with the Redis syntax
+func parseLexBound(s string) (LexBound, error) {
+	switch {
+	case s == "-" || s == "+":
+		return LexBound{Infinite: true}, nil
+	case strings.HasPrefix(s, "["):
+		return LexBound{Value: s[1:]}, nil
+	case strings.HasPrefix(s, "("):
+		return LexBound{Value: s[1:], Exclusive: true}, nil
+	default:
+		return LexBound{}, ErrInvalidLexBound
+	}
+}
+
+// firstInLexRange returns the first node whose member is within r and its 1-based rank
+// It returns nil if no node is in range
+func (sl *List) firstInLexRange(r LexRange) (*Node, int) {
+	if r.isEmpty() {
+		return nil, 0
+	}
+
+	x, rank := sl.header, 0
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && !r.gteMin(x.level[i].forward.obj) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+	}
+
+	x = x.level[0].forward
+	if x == nil || !r.lteMax(x.obj) {
+		return nil, 0
+	}
+	return x, rank + 1
+}
+
+// lastInLexRange returns the last node whose member is within r and its 1-based rank
+// It returns nil if no node is in range
+func (sl *List) lastInLexRange(r LexRange) (*Node, int) {
+	if r.isEmpty() {
+		return nil, 0
+	}
+
+	x, rank := sl.header, 0
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && r.lteMax(x.level[i].forward.obj) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+	}
+
+	if x == sl.header || !r.gteMin(x.obj) {
+		return nil, 0
+	}
+	return x, rank
+}
+
+// gteMin checks if obj satisfies the min bound of the range
+func (r LexRange) gteMin(obj interface{}) bool {
+	if r.Min.Infinite {
+		return true
+	}
+	cmp := utils.CompareObjects(obj, r.Min.Value)
+	if r.Min.Exclusive {
+		return cmp > 0
+	}
+	return cmp >= 0
+}
+
+// lteMax checks if obj satisfies the max bound of the range
+func (r LexRange) lteMax(obj interface{}) bool {
+	if r.Max.Infinite {
+		return true
+	}
+	cmp := utils.CompareObjects(obj, r.Max.Value)
+	if r.Max.Exclusive {
+		return cmp < 0
+	}
+	return cmp <= 0
+}
+
+// isEmpty checks if no member can satisfy both bounds
+func (r LexRange) isEmpty() bool {
+	if r.Min.Infinite || r.Max.Infinite {
+		return false
+	}
+	cmp := utils.CompareObjects(r.Min.Value, r.Max.Value)
+	return cmp > 0 || (cmp == 0 && (r.Min.Exclusive || r.Max.Exclusive))
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"reflect"
+	"testing"
+)
+
+// newLexTestList builds a list holding the members a..g, all with the score 0
+func newLexTestList() *List {
+	sl := New()
+	for _, member := range []string{"d", "a", "g", "c", "e", "b", "f"} {
+		sl.Add(0, member)
+	}
+	return sl
+}
+
+// mustParseLexRange parses a range that is known to be valid
+func mustParseLexRange(t *testing.T, min, max string) LexRange {
+	t.Helper()
+	r, err := ParseLexRange(min, max)
+	if err != nil {
+		t.Fatalf("ParseLexRange(%q, %q): unexpected error %v", min, max, err)
+	}
+	return r
+}
+
+func TestList_RangeByLex(t *testing.T) {
+	sl := newLexTestList()
+
+	tests := []struct {
+		min, max      string
+		offset, limit int
+		expected      []interface{}
+	}{
+		{"-", "+", 0, -1, []interface{}{"a", "b", "c", "d", "e", "f", "g"}},
+		{"[b", "[d", 0, -1, []interface{}{"b", "c", "d"}},
+		{"(b", "(d", 0, -1, []interface{}{"c"}},
+		{"-", "(c", 0, -1, []interface{}{"a", "b"}},
+		{"[e", "+", 0, -1, []interface{}{"e", "f", "g"}},
+		{"[bb", "[dd", 0, -1, []interface{}{"c", "d"}},
+		{"-", "+", 2, 3, []interface{}{"c", "d", "e"}},
+		{"[c", "+", 10, -1, []interface{}{}},
+		{"[d", "[b", 0, -1, []interface{}{}},
+		{"(c", "(c", 0, -1, []interface{}{}},
+		{"+", "+", 0, -1, []interface{}{}},
+		{"-", "-", 0, -1, []interface{}{}},
+		{"[x", "+", 0, -1, []interface{}{}},
+	}
+
+	for _, tt := range tests {
+		r := mustParseLexRange(t, tt.min, tt.max)
+		if got := nodeObjs(sl.RangeByLex(r, tt.offset, tt.limit)); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("RangeByLex(%s, %s, %d, %d): expected %v, got %v", tt.min, tt.max, tt.offset, tt.limit, tt.expected, got)
+		}
+	}
+}
+
+func TestList_RevRangeByLex(t *testing.T) {
+	sl := newLexTestList()
+
+	tests := []struct {
+		min, max      string
+		offset, limit int
+		expected      []interface{}
+	}{
+		{"-", "+", 0, 3, []interface{}{"g", "f", "e"}},
+		{"[b", "(e", 0, -1, []interface{}{"d", "c", "b"}},
+		{"-", "+", 5, -1, []interface{}{"b", "a"}},
+		{"(f", "+", 1, -1, []interface{}{}},
+		{"-", "(a", 0, -1, []interface{}{}},
+	}
+
+	for _, tt := range tests {
+		r := mustParseLexRange(t, tt.min, tt.max)
+		if got := nodeObjs(sl.RevRangeByLex(r, tt.offset, tt.limit)); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("RevRangeByLex(%s, %s, %d, %d): expected %v, got %v", tt.min, tt.max, tt.offset, tt.limit, tt.expected, got)
+		}
+	}
+}
+
+func TestList_CountByLex(t *testing.T) {
+	sl := newLexTestList()
+
+	tests := []struct {
+		min, max string
+		expected int
+	}{
+		{"-", "+", 7},
+		{"[b", "[d", 3},
+		{"(b", "[d", 2},
+		{"[a", "(a", 0},
+		{"[z", "+", 0},
+		{"+", "-", 0},
+	}
+
+	for _, tt := range tests {
+		if got := sl.CountByLex(mustParseLexRange(t, tt.min, tt.max)); got != tt.expected {
+			t.Errorf("CountByLex(%s, %s): expected %d, got %d", tt.min, tt.max, tt.expected, got)
+		}
+	}
+}
+
+func TestParseLexRange(t *testing.T) {
+	r := mustParseLexRange(t, "(a", "[b")
+	expected := LexRange{Min: LexBound{Value: "a", Exclusive: true}, Max: LexBound{Value: "b"}}
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, r)
+	}
+
+	// Bounds must start with '[' or '(' unless they are '-' or '+'
+	for _, bounds := range [][2]string{{"a", "+"}, {"-", "b"}, {"", "+"}} {
+		if _, err := ParseLexRange(bounds[0], bounds[1]); err != ErrInvalidLexBound {
+			t.Errorf("ParseLexRange(%q, %q): expected ErrInvalidLexBound, got %v", bounds[0], bounds[1], err)
+		}
+	}
+}
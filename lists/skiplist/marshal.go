@@ -0,0 +1,75 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON encodes the skip list as a JSON array of {"score", "member"} objects in rank order
+// JSON cannot represent infinite scores, so lists holding them fail to encode.
+func (sl *List) MarshalJSON() ([]byte, error) {
+	return json.Marshal(sl.elements())
+}
+
+// UnmarshalJSON decodes a JSON array of score/member pairs, replacing the content of the skip list
+// Members are decoded with the default encoding/json types, so numbers become float64.
+func (sl *List) UnmarshalJSON(data []byte) error {
+	var elements []Element
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	sl.replace(elements)
+	return nil
+}
+
+// MarshalText encodes the skip list as its JSON representation
+func (sl *List) MarshalText() ([]byte, error) {
+	return sl.MarshalJSON()
+}
+
+// UnmarshalText decodes text produced by MarshalText, replacing the content of the skip list
+func (sl *List) UnmarshalText(text []byte) error {
+	return sl.UnmarshalJSON(text)
+}
+
+// GobEncode encodes the score/member pairs of the skip list with encoding/gob
+// Concrete member types other than the gob built-ins must be registered with gob.Register.
+func (sl *List) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(sl.elements()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes data produced by GobEncode, replacing the content of the skip list
+func (sl *List) GobDecode(data []byte) error {
+	var elements []Element
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elements); err != nil {
+		return err
+	}
+	sl.replace(elements)
+	return nil
+}
+
+// elements returns the score/member pairs of the skip list in rank order
+func (sl *List) elements() []Element {
+	elements := make([]Element, 0, sl.length)
+	for x := sl.header.level[0].forward; x != nil; x = x.level[0].forward {
+		elements = append(elements, Element{Score: x.score, Member: x.obj})
+	}
+	return elements
+}
+
+// replace drops all nodes of the skip list and adds the given pairs
+func (sl *List) replace(elements []Element) {
+	sl.reset()
+	for _, e := range elements {
+		sl.Add(e.Score, e.Member)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestList_MarshalJSON(t *testing.T) {
+	sl := New()
+	sl.Add(2.0, "b")
+	sl.Add(1.0, "a")
+
+	// Elements are encoded as score/member pairs in rank order
+	data, err := json.Marshal(sl)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `[{"score":1,"member":"a"},{"score":2,"member":"b"}]`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	decoded := New()
+	decoded.Add(9.0, "stale")
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Size() != 2 || decoded.Rank(1.0, "a") != 1 || decoded.Rank(2.0, "b") != 2 {
+		t.Errorf("Expected %v after round trip, got %v", sl, decoded)
+	}
+	checkSpans(t, decoded)
+
+	if data, _ := json.Marshal(New()); string(data) != "[]" {
+		t.Errorf("Expected empty skip list to encode as [], got %s", data)
+	}
+
+	// JSON has no representation for infinity
+	inf := New()
+	inf.Add(math.Inf(1), "inf")
+	if _, err := json.Marshal(inf); err == nil {
+		t.Errorf("Expected an error when encoding an infinite score")
+	}
+}
+
+func TestList_MarshalText(t *testing.T) {
+	sl := New()
+	sl.Add(1.5, "x")
+	text, err := sl.MarshalText()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	decoded := New()
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !decoded.Contains(1.5, "x") || decoded.Size() != 1 {
+		t.Errorf("Expected %v after round trip, got %v", sl, decoded)
+	}
+}
+
+func TestList_GobEncode(t *testing.T) {
+	// Unlike JSON, gob keeps infinite scores
+	sl := New()
+	sl.Add(1.0, "a")
+	sl.Add(math.Inf(1), "inf")
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(sl); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := New()
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Size() != 2 || decoded.Rank(math.Inf(1), "inf") != 2 {
+		t.Errorf("Expected %v after round trip, got %v", sl, decoded)
+	}
+}
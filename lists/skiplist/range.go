@@ -0,0 +1,191 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+// RangeByScore returns the nodes whose score is within r, from the lowest to the highest score
+// The first offset matching nodes are skipped and at most limit nodes are returned;
+// a negative limit returns all remaining nodes, like LIMIT in Redis ZRANGEBYSCORE.
+func (sl *List) RangeByScore(r ScoreRange, offset, limit int) []*Node {
+	x, rank := sl.firstInScoreRange(r)
+	if x == nil || offset < 0 {
+		return nil
+	}
+
+	// Jump over the offset using the spans instead of walking node by node
+	if offset > 0 {
+		x = sl.GetByRank(rank + offset)
+	}
+
+	var nodes []*Node
+	for ; x != nil && limit != 0 && r.lteMax(x.score); x = x.level[0].forward {
+		nodes = append(nodes, x)
+		limit--
+	}
+	return nodes
+}
+
+// RevRangeByScore returns the nodes whose score is within r, from the highest to the lowest score
+// offset and limit behave as in RangeByScore, counting from the highest score.
+func (sl *List) RevRangeByScore(r ScoreRange, offset, limit int) []*Node {
+	x, rank := sl.lastInScoreRange(r)
+	if x == nil || offset < 0 {
+		return nil
+	}
+
+	if offset > 0 {
+		x = sl.GetByRank(rank - offset)
+	}
+
+	var nodes []*Node
+	for ; x != nil && limit != 0 && r.gteMin(x.score); x = x.backward {
+		nodes = append(nodes, x)
+		limit--
+	}
+	return nodes
+}
+
+// RangeByRank returns the nodes between the 0-based ranks start and stop, both inclusive
+// Negative indexes count from the end, so -1 is the node with the highest score, like Redis ZRANGE.
+func (sl *List) RangeByRank(start, stop int) []*Node {
+	start, stop, ok := normalizeRankRange(start, stop, int(sl.length))
+	if !ok {
+		return nil
+	}
+
+	// GetByRank is 1-based
+	nodes := make([]*Node, 0, stop-start+1)
+	for x := sl.GetByRank(start + 1); len(nodes) < cap(nodes); x = x.level[0].forward {
+		nodes = append(nodes, x)
+	}
+	return nodes
+}
+
+// RevRangeByRank returns the nodes between the 0-based ranks start and stop counted from the highest score
+// Index 0 is the node with the highest score and negative indexes count from the lowest score.
+func (sl *List) RevRangeByRank(start, stop int) []*Node {
+	start, stop, ok := normalizeRankRange(start, stop, int(sl.length))
+	if !ok {
+		return nil
+	}
+
+	nodes := make([]*Node, 0, stop-start+1)
+	for x := sl.GetByRank(int(sl.length) - start); len(nodes) < cap(nodes); x = x.backward {
+		nodes = append(nodes, x)
+	}
+	return nodes
+}
+
+// CountInScore returns the number of nodes whose score is within r
+// It only descends the list twice and uses the spans to count, so it runs in O(log n).
+func (sl *List) CountInScore(r ScoreRange) int {
+	if r.isEmpty() {
+		return 0
+	}
+	// Nodes up to the max bound minus nodes below the min bound
+	return sl.countWhile(func(node *Node) bool { return r.lteMax(node.score) }) -
+		sl.countWhile(func(node *Node) bool { return !r.gteMin(node.score) })
+}
+
+// firstInScoreRange returns the first node whose score is within r and its 1-based rank
+// It returns nil if no node is in range
+func (sl *List) firstInScoreRange(r ScoreRange) (*Node, int) {
+	if r.isEmpty() {
+		return nil, 0
+	}
+
+	x, rank := sl.header, 0
+	for i := sl.level - 1; i >= 0; i-- {
+		// Go forward while the next node is below the min bound
+		for x.level[i].forward != nil && !r.gteMin(x.level[i].forward.score) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+	}
+
+	// The next node is the first one not below the min bound, check the max bound
+	x = x.level[0].forward
+	if x == nil || !r.lteMax(x.score) {
+		return nil, 0
+	}
+	return x, rank + 1
+}
+
+// lastInScoreRange returns the last node whose score is within r and its 1-based rank
+// It returns nil if no node is in range
+func (sl *List) lastInScoreRange(r ScoreRange) (*Node, int) {
+	if r.isEmpty() {
+		return nil, 0
+	}
+
+	x, rank := sl.header, 0
+	for i := sl.level - 1; i >= 0; i-- {
+		// Go forward while the next node is still within the max bound
+		for x.level[i].forward != nil && r.lteMax(x.level[i].forward.score) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+	}
+
+	// x is the last node not above the max bound, check the min bound
+	if x == sl.header || !r.gteMin(x.score) {
+		return nil, 0
+	}
+	return x, rank
+}
+
+// countWhile returns the number of leading nodes that satisfy pred
+// pred must be monotonic: once it fails for a node it fails for every following node.
+func (sl *List) countWhile(pred func(node *Node) bool) int {
+	x, rank := sl.header, 0
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && pred(x.level[i].forward) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+	}
+	return rank
+}
+
+// normalizeRankRange converts possibly negative 0-based ranks into a valid inclusive range of a list of length nodes
+// The boolean is false if the range is empty
+func normalizeRankRange(start, stop, length int) (int, int, bool) {
+	if start < 0 {
+		start += length
+	}
+	if stop < 0 {
+		stop += length
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= length {
+		stop = length - 1
+	}
+	if start > stop || start >= length {
+		return 0, 0, false
+	}
+	return start, stop, true
+}
+
+// gteMin checks if score satisfies the min bound of the range
+func (r ScoreRange) gteMin(score float64) bool {
+	if r.MinExclusive {
+		return score > r.Min
+	}
+	return score >= r.Min
+}
+
+// lteMax checks if score satisfies the max bound of the range
+func (r ScoreRange) lteMax(score float64) bool {
+	if r.MaxExclusive {
+		return score < r.Max
+	}
+	return score <= r.Max
+}
+
+// isEmpty checks if no score can satisfy both bounds
+func (r ScoreRange) isEmpty() bool {
+	return r.Min > r.Max || (r.Min == r.Max && (r.MinExclusive || r.MaxExclusive))
+}
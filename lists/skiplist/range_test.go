@@ -0,0 +1,205 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// newRangeTestList builds a list holding the members a..j with scores 1..10
+func newRangeTestList() *List {
+	sl := New()
+	for i, member := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		sl.Add(float64(i+1), member)
+	}
+	return sl
+}
+
+// nodeObjs returns the objects of the nodes, to compare results easily
+func nodeObjs(nodes []*Node) []interface{} {
+	objs := make([]interface{}, 0, len(nodes))
+	for _, node := range nodes {
+		objs = append(objs, node.obj)
+	}
+	return objs
+}
+
+func TestList_RangeByScore(t *testing.T) {
+	sl := newRangeTestList()
+
+	tests := []struct {
+		name          string
+		r             ScoreRange
+		offset, limit int
+		expected      []interface{}
+	}{
+		{"inclusive", ScoreRange{Min: 2, Max: 4}, 0, -1, []interface{}{"b", "c", "d"}},
+		{"exclusive min", ScoreRange{Min: 2, Max: 4, MinExclusive: true}, 0, -1, []interface{}{"c", "d"}},
+		{"exclusive max", ScoreRange{Min: 2, Max: 4, MaxExclusive: true}, 0, -1, []interface{}{"b", "c"}},
+		{"infinite", ScoreRange{Min: math.Inf(-1), Max: math.Inf(1)}, 0, 3, []interface{}{"a", "b", "c"}},
+		{"offset", ScoreRange{Min: 3, Max: 8}, 2, 2, []interface{}{"e", "f"}},
+		{"offset past the range", ScoreRange{Min: 3, Max: 4}, 5, -1, []interface{}{}},
+		{"zero limit", ScoreRange{Min: 1, Max: 10}, 0, 0, []interface{}{}},
+		{"negative offset", ScoreRange{Min: 1, Max: 10}, -1, -1, []interface{}{}},
+		{"empty range", ScoreRange{Min: 5, Max: 5, MinExclusive: true}, 0, -1, []interface{}{}},
+		{"inverted range", ScoreRange{Min: 5, Max: 4}, 0, -1, []interface{}{}},
+		{"outside", ScoreRange{Min: 11, Max: 20}, 0, -1, []interface{}{}},
+		{"between nodes", ScoreRange{Min: 4.2, Max: 4.8}, 0, -1, []interface{}{}},
+	}
+
+	for _, tt := range tests {
+		if got := nodeObjs(sl.RangeByScore(tt.r, tt.offset, tt.limit)); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, got)
+		}
+	}
+}
+
+func TestList_RevRangeByScore(t *testing.T) {
+	sl := newRangeTestList()
+
+	tests := []struct {
+		name          string
+		r             ScoreRange
+		offset, limit int
+		expected      []interface{}
+	}{
+		{"inclusive", ScoreRange{Min: 2, Max: 4}, 0, -1, []interface{}{"d", "c", "b"}},
+		{"exclusive", ScoreRange{Min: 2, Max: 4, MinExclusive: true, MaxExclusive: true}, 0, -1, []interface{}{"c"}},
+		{"offset", ScoreRange{Min: 3, Max: 8}, 2, 2, []interface{}{"f", "e"}},
+		{"infinite", ScoreRange{Min: math.Inf(-1), Max: math.Inf(1)}, 8, -1, []interface{}{"b", "a"}},
+		{"offset past the range", ScoreRange{Min: 3, Max: 4}, 2, -1, []interface{}{}},
+		{"outside", ScoreRange{Min: -5, Max: 0}, 0, -1, []interface{}{}},
+	}
+
+	for _, tt := range tests {
+		if got := nodeObjs(sl.RevRangeByScore(tt.r, tt.offset, tt.limit)); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, got)
+		}
+	}
+}
+
+func TestList_RangeByRank(t *testing.T) {
+	sl := newRangeTestList()
+
+	tests := []struct {
+		start, stop int
+		expected    []interface{}
+	}{
+		{0, 2, []interface{}{"a", "b", "c"}},
+		{-3, -1, []interface{}{"h", "i", "j"}},
+		{8, 100, []interface{}{"i", "j"}},
+		{-100, 0, []interface{}{"a"}},
+		{5, 4, []interface{}{}},
+		{10, 12, []interface{}{}},
+	}
+
+	for _, tt := range tests {
+		if got := nodeObjs(sl.RangeByRank(tt.start, tt.stop)); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("RangeByRank(%d, %d): expected %v, got %v", tt.start, tt.stop, tt.expected, got)
+		}
+	}
+
+	if got := New().RangeByRank(0, -1); len(got) != 0 {
+		t.Errorf("Expected no nodes from an empty list, got %v", nodeObjs(got))
+	}
+}
+
+func TestList_RevRangeByRank(t *testing.T) {
+	sl := newRangeTestList()
+
+	tests := []struct {
+		start, stop int
+		expected    []interface{}
+	}{
+		{0, 2, []interface{}{"j", "i", "h"}},
+		{-2, -1, []interface{}{"b", "a"}},
+		{0, -1, []interface{}{"j", "i", "h", "g", "f", "e", "d", "c", "b", "a"}},
+		{3, 1, []interface{}{}},
+	}
+
+	for _, tt := range tests {
+		if got := nodeObjs(sl.RevRangeByRank(tt.start, tt.stop)); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("RevRangeByRank(%d, %d): expected %v, got %v", tt.start, tt.stop, tt.expected, got)
+		}
+	}
+}
+
+func TestList_CountInScore(t *testing.T) {
+	sl := newRangeTestList()
+	sl.Add(5, "e2") // Two nodes share the score 5
+
+	tests := []struct {
+		r        ScoreRange
+		expected int
+	}{
+		{ScoreRange{Min: 1, Max: 10}, 11},
+		{ScoreRange{Min: 5, Max: 5}, 2},
+		{ScoreRange{Min: 5, Max: 6, MinExclusive: true}, 1},
+		{ScoreRange{Min: 4, Max: 5, MaxExclusive: true}, 1},
+		{ScoreRange{Min: math.Inf(-1), Max: 3}, 3},
+		{ScoreRange{Min: 20, Max: 30}, 0},
+		{ScoreRange{Min: 6, Max: 2}, 0},
+	}
+
+	for _, tt := range tests {
+		if got := sl.CountInScore(tt.r); got != tt.expected {
+			t.Errorf("CountInScore(%+v): expected %d, got %d", tt.r, tt.expected, got)
+		}
+	}
+}
+
+func TestList_CountInScore_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sl := New()
+	var scores []float64
+	for i := 0; i < 2000; i++ {
+		score := float64(r.Intn(500))
+		sl.Add(score, i)
+		scores = append(scores, score)
+	}
+
+	// Compare against a brute-force count over random ranges
+	for i := 0; i < 200; i++ {
+		rng := ScoreRange{
+			Min:          float64(r.Intn(520) - 10),
+			Max:          float64(r.Intn(520) - 10),
+			MinExclusive: r.Intn(2) == 0,
+			MaxExclusive: r.Intn(2) == 0,
+		}
+		expected := 0
+		for _, score := range scores {
+			if rng.gteMin(score) && rng.lteMax(score) {
+				expected++
+			}
+		}
+		if got := sl.CountInScore(rng); got != expected {
+			t.Fatalf("CountInScore(%+v): expected %d, got %d", rng, expected, got)
+		}
+		if got := len(sl.RangeByScore(rng, 0, -1)); got != expected {
+			t.Fatalf("RangeByScore(%+v): expected %d nodes, got %d", rng, expected, got)
+		}
+	}
+}
+
+func TestList_BackwardOfFirstNode(t *testing.T) {
+	sl := New()
+	sl.Add(2, "b")
+	sl.Add(1, "a")
+
+	// The first node has no predecessor, the header is not a real node
+	if first := sl.GetByRank(1); first.backward != nil {
+		t.Errorf("Expected the first node to have no backward pointer")
+	}
+
+	// Removing every node leaves no tail behind
+	sl.Remove(1, "a")
+	sl.Remove(2, "b")
+	if sl.tail != nil {
+		t.Errorf("Expected tail to be nil after removing every node")
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import "slices"
+
+// RemoveRangeByScore removes every node whose score is within r and returns the number of removed nodes
+// If fn is not nil, it is called with each removed node in ascending order.
+func (sl *List) RemoveRangeByScore(r ScoreRange, fn func(node *Node)) int {
+	if r.isEmpty() {
+		return 0
+	}
+
+	// Find the last node below the range at each level
+	update := make([]*Node, sl.level)
+	x := sl.header
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && !r.gteMin(x.level[i].forward.score) {
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+
+	// Unlink the run of nodes in range, update stays valid as every removed node follows it
+	return sl.removeRun(update, x.level[0].forward, func(node *Node) bool {
+		return r.lteMax(node.score)
+	}, fn)
+}
+
+// RemoveRangeByRank removes the nodes between the 0-based ranks start and stop, both inclusive,
+// and returns the number of removed nodes. Negative indexes count from the end, like RangeByRank.
+// If fn is not nil, it is called with each removed node in ascending order.
+func (sl *List) RemoveRangeByRank(start, stop int, fn func(node *Node)) int {
+	start, stop, ok := normalizeRankRange(start, stop, int(sl.length))
+	if !ok {
+		return 0
+	}
+
+	// Find the node right before start at each level
+	update := make([]*Node, sl.level)
+	x, traversed := sl.header, 0
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && traversed+x.level[i].span <= start {
+			traversed += x.level[i].span
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+
+	remaining := stop - start + 1
+	return sl.removeRun(update, x.level[0].forward, func(*Node) bool {
+		remaining--
+		return remaining >= 0
+	}, fn)
+}
+
+// RemoveRangeByLex removes every node whose member is within r and returns the number of removed nodes
+// Like Redis ZREMRANGEBYLEX, it is only meaningful when all nodes share the same score.
+// If fn is not nil, it is called with each removed node in ascending order.
+func (sl *List) RemoveRangeByLex(r LexRange, fn func(node *Node)) int {
+	if r.isEmpty() {
+		return 0
+	}
+
+	update := make([]*Node, sl.level)
+	x := sl.header
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && !r.gteMin(x.level[i].forward.obj) {
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+
+	return sl.removeRun(update, x.level[0].forward, func(node *Node) bool {
+		return r.lteMax(node.obj)
+	}, fn)
+}
+
+// removeRun unlinks consecutive nodes starting at x for as long as inRange accepts them
+// update must hold the last node before x at each level. It returns the number of removed nodes.
+func (sl *List) removeRun(update []*Node, x *Node, inRange func(node *Node) bool, fn func(node *Node)) int {
+	removed := 0
+	for x != nil && inRange(x) {
+		next := x.level[0].forward
+		sl.removeNode(x, update)
+		if fn != nil {
+			fn(x)
+		}
+		sl.freeNode(x)
+		removed++
+		x = next
+	}
+	return removed
+}
+
+// PopMin removes the n nodes with the lowest scores and returns them as elements, from the lowest score
+// It returns nil if n is not positive or the list is empty.
+func (sl *List) PopMin(n int) []Element {
+	if n <= 0 {
+		return nil
+	}
+
+	var elements []Element
+	sl.RemoveRangeByRank(0, n-1, func(node *Node) {
+		elements = append(elements, Element{Score: node.score, Member: node.obj})
+	})
+	return elements
+}
+
+// PopMax removes the n nodes with the highest scores and returns them as elements, from the highest score
+// It returns nil if n is not positive or the list is empty.
+func (sl *List) PopMax(n int) []Element {
+	if n <= 0 {
+		return nil
+	}
+
+	var elements []Element
+	sl.RemoveRangeByRank(-n, -1, func(node *Node) {
+		elements = append(elements, Element{Score: node.score, Member: node.obj})
+	})
+	// Nodes are removed in ascending order
+	slices.Reverse(elements)
+	return elements
+}
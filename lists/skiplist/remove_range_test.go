@@ -0,0 +1,208 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// checkRanks verifies that every node can still be reached by its rank after a removal
+func checkRanks(t *testing.T, sl *List, expected []interface{}) {
+	t.Helper()
+	if int(sl.length) != len(expected) {
+		t.Fatalf("Expected length %d, got %d", len(expected), sl.length)
+	}
+	for i, obj := range expected {
+		node := sl.GetByRank(i + 1)
+		if node == nil || node.obj != obj {
+			t.Fatalf("Expected %v at rank %d, got %v", obj, i+1, node)
+		}
+		if rank := sl.Rank(node.score, node.obj); rank != i+1 {
+			t.Fatalf("Expected rank %d for %v, got %d", i+1, obj, rank)
+		}
+	}
+	if got := nodeObjs(sl.RevRangeByRank(0, -1)); len(expected) > 0 && got[0] != expected[len(expected)-1] {
+		t.Fatalf("Expected tail %v, got %v", expected[len(expected)-1], got[0])
+	}
+}
+
+func TestList_RemoveRangeByScore(t *testing.T) {
+	tests := []struct {
+		name      string
+		r         ScoreRange
+		removed   []interface{}
+		remaining []interface{}
+	}{
+		{"middle", ScoreRange{Min: 3, Max: 5}, []interface{}{"c", "d", "e"},
+			[]interface{}{"a", "b", "f", "g", "h", "i", "j"}},
+		{"exclusive", ScoreRange{Min: 3, Max: 5, MinExclusive: true, MaxExclusive: true}, []interface{}{"d"},
+			[]interface{}{"a", "b", "c", "e", "f", "g", "h", "i", "j"}},
+		{"head", ScoreRange{Min: math.Inf(-1), Max: 2}, []interface{}{"a", "b"},
+			[]interface{}{"c", "d", "e", "f", "g", "h", "i", "j"}},
+		{"tail", ScoreRange{Min: 9, Max: math.Inf(1)}, []interface{}{"i", "j"},
+			[]interface{}{"a", "b", "c", "d", "e", "f", "g", "h"}},
+		{"everything", ScoreRange{Min: 1, Max: 10}, []interface{}{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"},
+			[]interface{}{}},
+		{"nothing", ScoreRange{Min: 4.2, Max: 4.8}, []interface{}{},
+			[]interface{}{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}},
+		{"inverted", ScoreRange{Min: 5, Max: 3}, []interface{}{},
+			[]interface{}{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}},
+	}
+
+	for _, tt := range tests {
+		sl := newRangeTestList()
+		removed := []interface{}{}
+		n := sl.RemoveRangeByScore(tt.r, func(node *Node) {
+			removed = append(removed, node.obj)
+		})
+
+		if n != len(tt.removed) || !reflect.DeepEqual(removed, tt.removed) {
+			t.Errorf("%s: expected to remove %v, removed %d %v", tt.name, tt.removed, n, removed)
+		}
+		checkRanks(t, sl, tt.remaining)
+	}
+
+	// A nil callback is allowed
+	sl := newRangeTestList()
+	if n := sl.RemoveRangeByScore(ScoreRange{Min: 1, Max: 3}, nil); n != 3 {
+		t.Errorf("Expected 3 removed nodes, got %d", n)
+	}
+	if sl.GetByRank(1).backward != nil {
+		t.Errorf("Expected the new first node to have no backward pointer")
+	}
+}
+
+func TestList_RemoveRangeByRank(t *testing.T) {
+	tests := []struct {
+		start, stop int
+		remaining   []interface{}
+	}{
+		{0, 2, []interface{}{"d", "e", "f", "g", "h", "i", "j"}},
+		{-2, -1, []interface{}{"a", "b", "c", "d", "e", "f", "g", "h"}},
+		{4, 4, []interface{}{"a", "b", "c", "d", "f", "g", "h", "i", "j"}},
+		{8, 100, []interface{}{"a", "b", "c", "d", "e", "f", "g", "h"}},
+		{0, -1, []interface{}{}},
+		{5, 4, []interface{}{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}},
+		{10, 12, []interface{}{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}},
+	}
+
+	for _, tt := range tests {
+		sl := newRangeTestList()
+		if n := sl.RemoveRangeByRank(tt.start, tt.stop, nil); n != 10-len(tt.remaining) {
+			t.Errorf("RemoveRangeByRank(%d, %d): expected %d removed nodes, got %d", tt.start, tt.stop, 10-len(tt.remaining), n)
+		}
+		checkRanks(t, sl, tt.remaining)
+	}
+}
+
+func TestList_RemoveRangeByLex(t *testing.T) {
+	sl := New()
+	for _, member := range []string{"a", "b", "c", "d", "e"} {
+		sl.Add(0, member)
+	}
+
+	removed := []interface{}{}
+	n := sl.RemoveRangeByLex(LexRange{
+		Min: LexBound{Value: "b"},
+		Max: LexBound{Value: "d", Exclusive: true},
+	}, func(node *Node) {
+		removed = append(removed, node.obj)
+	})
+	if n != 2 || !reflect.DeepEqual(removed, []interface{}{"b", "c"}) {
+		t.Errorf("Expected to remove [b c], removed %d %v", n, removed)
+	}
+	checkRanks(t, sl, []interface{}{"a", "d", "e"})
+
+	if n := sl.RemoveRangeByLex(LexRange{Min: LexBound{Value: "d"}, Max: LexBound{Infinite: true}}, nil); n != 2 {
+		t.Errorf("Expected 2 removed nodes, got %d", n)
+	}
+	checkRanks(t, sl, []interface{}{"a"})
+
+	if n := sl.RemoveRangeByLex(LexRange{Min: LexBound{Value: "z"}, Max: LexBound{Value: "a"}}, nil); n != 0 {
+		t.Errorf("Expected an inverted range to remove nothing, got %d", n)
+	}
+}
+
+func TestList_RemoveRange_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sl := New()
+	var expected []interface{}
+	for i := 0; i < 1000; i++ {
+		sl.Add(float64(i), i)
+		expected = append(expected, i)
+	}
+
+	// Alternate both kinds of removal and keep a brute-force copy in sync
+	for len(expected) > 0 {
+		start := r.Intn(len(expected))
+		stop := start + r.Intn(20)
+		if r.Intn(2) == 0 {
+			sl.RemoveRangeByRank(start, stop, nil)
+		} else {
+			last := min(stop, len(expected)-1)
+			sl.RemoveRangeByScore(ScoreRange{
+				Min: float64(expected[start].(int)),
+				Max: float64(expected[last].(int)),
+			}, nil)
+		}
+		expected = append(expected[:start], expected[min(stop+1, len(expected)):]...)
+		checkRanks(t, sl, expected)
+	}
+	if sl.level != 1 {
+		t.Errorf("Expected an empty list to shrink back to level 1, got %d", sl.level)
+	}
+}
+
+func TestSortedSet_ZRemRange(t *testing.T) {
+	z := NewSortedSet()
+	_, _ = z.ZAdd(0, Element{1, "a"}, Element{2, "b"}, Element{3, "c"}, Element{4, "d"}, Element{5, "e"})
+
+	if n := z.ZRemRangeByScore(ScoreRange{Min: 2, Max: 3}); n != 2 {
+		t.Errorf("Expected 2 removed members, got %d", n)
+	}
+	if n := z.ZRemRangeByRank(-1, -1); n != 1 {
+		t.Errorf("Expected 1 removed member, got %d", n)
+	}
+	if _, ok := z.ZScore("b"); ok {
+		t.Errorf("Expected b to be removed from the dictionary")
+	}
+	if z.ZCard() != 2 || z.ZRank("d") != 1 {
+		t.Errorf("Expected [a d], got %v", z)
+	}
+
+	_, _ = z.ZAdd(0, Element{0, "x"}, Element{0, "y"})
+	if n := z.ZRemRangeByLex(LexRange{Min: LexBound{Infinite: true}, Max: LexBound{Value: "x"}}); n != 1 {
+		t.Errorf("Expected 1 removed member, got %d", n)
+	}
+	if z.String() != "SortedSet elements: [y: 0, a: 1, d: 4]" {
+		t.Errorf("Unexpected sorted set %v", z)
+	}
+}
+
+func TestList_PopMinMax(t *testing.T) {
+	sl := newRangeTestList()
+
+	if got := sl.PopMin(2); !reflect.DeepEqual(got, []Element{{1, "a"}, {2, "b"}}) {
+		t.Errorf("PopMin(2): unexpected %v", got)
+	}
+	if got := sl.PopMax(3); !reflect.DeepEqual(got, []Element{{10, "j"}, {9, "i"}, {8, "h"}}) {
+		t.Errorf("PopMax(3): unexpected %v", got)
+	}
+	if got := sl.PopMin(0); got != nil {
+		t.Errorf("PopMin(0): expected nil, got %v", got)
+	}
+	checkRanks(t, sl, []interface{}{"c", "d", "e", "f", "g"})
+
+	// Asking for more than available pops everything
+	if got := sl.PopMax(100); len(got) != 5 || got[0].Member != "g" || got[4].Member != "c" {
+		t.Errorf("PopMax(100): unexpected %v", got)
+	}
+	if got := sl.PopMin(1); got != nil || !sl.IsEmpty() {
+		t.Errorf("Expected nothing left to pop, got %v", got)
+	}
+}
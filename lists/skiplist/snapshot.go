@@ -0,0 +1,264 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/ethan-gao-code/go-ds/utils"
+)
+
+// snapshotMagic starts every snapshot written by WriteTo, the last byte is the format version
+var snapshotMagic = [4]byte{'G', 'S', 'L', 1}
+
+// maxSnapshotMember bounds the size of an encoded member read back from a snapshot
+// It stops a corrupted length from allocating gigabytes.
+const maxSnapshotMember = 1 << 30
+
+// MemberCodec converts the members of a skip list to and from bytes for snapshots
+type MemberCodec interface {
+	Encode(member interface{}) ([]byte, error) // Encodes a member
+	Decode(data []byte) (interface{}, error)   // Decodes a member encoded by Encode
+}
+
+// StringCodec stores string members as raw bytes, other member types cannot be encoded
+type StringCodec struct{}
+
+// Encode returns the bytes of a string member
+func (StringCodec) Encode(member interface{}) ([]byte, error) {
+	s, ok := member.(string)
+	if !ok {
+		return nil, fmt.Errorf("skiplist: StringCodec cannot encode member of type %T", member)
+	}
+	return []byte(s), nil
+}
+
+// Decode returns the bytes as a string member
+func (StringCodec) Decode(data []byte) (interface{}, error) {
+	return string(data), nil
+}
+
+// JSONCodec stores members as JSON, it is the codec used by WriteTo and ReadFrom
+// Members are decoded with the default encoding/json types, so numbers become float64.
+type JSONCodec struct{}
+
+// Encode returns the JSON encoding of a member
+func (JSONCodec) Encode(member interface{}) ([]byte, error) {
+	return json.Marshal(member)
+}
+
+// Decode decodes a JSON member
+func (JSONCodec) Decode(data []byte) (interface{}, error) {
+	var member interface{}
+	if err := json.Unmarshal(data, &member); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// WriteTo streams a snapshot of the skip list to w, members are encoded with JSONCodec
+// It implements io.WriterTo and returns the number of bytes written.
+func (sl *List) WriteTo(w io.Writer) (int64, error) {
+	return sl.WriteToWithCodec(w, JSONCodec{})
+}
+
+// WriteToWithCodec streams a snapshot of the skip list to w, members are encoded with codec
+// The snapshot holds a header, the number of elements, then the score bits,
+// the length and the bytes of every encoded member in rank order.
+func (sl *List) WriteToWithCodec(w io.Writer, codec MemberCodec) (int64, error) {
+	cw := &countingWriter{w: w}
+	buf := bufio.NewWriter(cw)
+
+	var header [12]byte
+	copy(header[:4], snapshotMagic[:])
+	binary.BigEndian.PutUint64(header[4:], sl.length)
+	if _, err := buf.Write(header[:]); err != nil {
+		return cw.n, err
+	}
+
+	var prefix [12]byte
+	for x := sl.header.level[0].forward; x != nil; x = x.level[0].forward {
+		data, err := codec.Encode(x.obj)
+		if err != nil {
+			return cw.n, err
+		}
+		if len(data) > maxSnapshotMember {
+			return cw.n, fmt.Errorf("skiplist: encoded member of %d bytes is too large", len(data))
+		}
+		binary.BigEndian.PutUint64(prefix[:8], math.Float64bits(x.score))
+		binary.BigEndian.PutUint32(prefix[8:], uint32(len(data)))
+		if _, err := buf.Write(prefix[:]); err != nil {
+			return cw.n, err
+		}
+		if _, err := buf.Write(data); err != nil {
+			return cw.n, err
+		}
+	}
+
+	err := buf.Flush()
+	return cw.n, err
+}
+
+// ReadFrom replaces the content of the skip list with a snapshot read from r, members are decoded with JSONCodec
+// It implements io.ReaderFrom and returns the number of bytes read.
+func (sl *List) ReadFrom(r io.Reader) (int64, error) {
+	return sl.ReadFromWithCodec(r, JSONCodec{})
+}
+
+// ReadFromWithCodec replaces the content of the skip list with a snapshot read from r, members are decoded with codec
+// The list is rebuilt with BulkLoad and is left untouched if the snapshot is invalid.
+// It reads exactly the bytes of the snapshot, so r can carry more data after it.
+func (sl *List) ReadFromWithCodec(r io.Reader, codec MemberCodec) (int64, error) {
+	cr := &countingReader{r: r}
+
+	var header [12]byte
+	if _, err := io.ReadFull(cr, header[:]); err != nil {
+		return cr.n, snapshotError(err)
+	}
+	if [4]byte(header[:4]) != snapshotMagic {
+		return cr.n, ErrInvalidSnapshot
+	}
+	count := binary.BigEndian.Uint64(header[4:])
+
+	// The count is not trusted to size the slice, a corrupted one would allocate too much
+	elements := make([]Element, 0, min(count, 1<<16))
+	var prefix [12]byte
+	for i := uint64(0); i < count; i++ {
+		if _, err := io.ReadFull(cr, prefix[:]); err != nil {
+			return cr.n, snapshotError(err)
+		}
+		score := math.Float64frombits(binary.BigEndian.Uint64(prefix[:8]))
+		size := binary.BigEndian.Uint32(prefix[8:])
+		if size > maxSnapshotMember {
+			return cr.n, ErrInvalidSnapshot
+		}
+
+		data := make([]byte, size)
+		if _, err := io.ReadFull(cr, data); err != nil {
+			return cr.n, snapshotError(err)
+		}
+		member, err := codec.Decode(data)
+		if err != nil {
+			return cr.n, err
+		}
+		elements = append(elements, Element{Score: score, Member: member})
+	}
+
+	if err := sl.BulkLoad(elements); err != nil {
+		return cr.n, err
+	}
+	return cr.n, nil
+}
+
+// BulkLoad replaces the content of the skip list with elements, which must be sorted by score, then member
+// The list is built in O(n) by linking the nodes in order, instead of searching the position of each one.
+// It returns ErrNaNScore, ErrNotSorted, or ErrDuplicateMember and ErrUnhashableMember in a list created with NewUnique,
+// and leaves the list untouched if elements cannot be loaded.
+func (sl *List) BulkLoad(elements []Element) error {
+	var seen map[interface{}]struct{}
+	if sl.members != nil {
+		seen = make(map[interface{}]struct{}, len(elements))
+	}
+	for i, e := range elements {
+		if math.IsNaN(e.Score) {
+			return ErrNaNScore
+		}
+		if i > 0 && compareElements(elements[i-1], e) > 0 {
+			return ErrNotSorted
+		}
+		if seen != nil {
+			if !hashable(e.Member) {
+				return ErrUnhashableMember
+			}
+			if _, ok := seen[e.Member]; ok {
+				return ErrDuplicateMember
+			}
+			seen[e.Member] = struct{}{}
+		}
+	}
+
+	sl.reset()
+
+	// last[i] is the last node linked at level i and lastRank[i] its rank, the header has rank 0
+	last := make([]*Node, sl.levels.maxLevel)
+	lastRank := make([]int, sl.levels.maxLevel)
+	for i := range last {
+		last[i] = sl.header
+	}
+
+	for i, e := range elements {
+		rank, prev := i+1, last[0]
+		node := newNode(sl.levels.next(), e.Score, e.Member)
+		if sl.members != nil {
+			sl.members[e.Member] = node
+		}
+		for j := range node.level {
+			last[j].level[j].forward = node
+			last[j].level[j].span = rank - lastRank[j]
+			last[j], lastRank[j] = node, rank
+		}
+		if prev != sl.header {
+			node.backward = prev
+		}
+		sl.level = max(sl.level, len(node.level))
+	}
+
+	// The last node of every level spans up to the end of the list
+	for i := 0; i < sl.level; i++ {
+		last[i].level[i].span = len(elements) - lastRank[i]
+	}
+	if len(elements) > 0 {
+		sl.tail = last[0]
+	}
+	sl.length = uint64(len(elements))
+	return nil
+}
+
+// reset drops all nodes of the skip list, keeping its level distribution and whether members are unique
+func (sl *List) reset() {
+	// Keep counting versions so that iterators over the old content notice the change
+	version, levels, unique := sl.version, sl.levels, sl.members != nil
+	*sl = *NewWithOptions(levels.maxLevel, levels.probability, nil)
+	sl.version, sl.levels = version+1, levels
+	if unique {
+		sl.members = make(map[interface{}]*Node)
+	}
+}
+
+// compareElements orders elements by score, then member, like the nodes of a skip list
+func compareElements(a, b Element) int {
+	switch {
+	case a.Score < b.Score:
+		return -1
+	case a.Score > b.Score:
+		return 1
+	}
+	return utils.CompareObjects(a.Member, b.Member)
+}
+
+// snapshotError reports a snapshot cut short as ErrInvalidSnapshot
+func snapshotError(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return ErrInvalidSnapshot
+	}
+	return err
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// checkSpans verifies the forward pointers and spans of every level against the rank of the nodes
+func checkSpans(t *testing.T, sl *List) {
+	t.Helper()
+	ranks := map[*Node]int{sl.header: 0}
+	rank := 0
+	for x := sl.header.level[0].forward; x != nil; x = x.level[0].forward {
+		rank++
+		ranks[x] = rank
+	}
+	for i := 0; i < sl.level; i++ {
+		for x := sl.header; x != nil; x = x.level[i].forward {
+			next := x.level[i].forward
+			expected := int(sl.length) - ranks[x]
+			if next != nil {
+				expected = ranks[next] - ranks[x]
+			}
+			if x.level[i].span != expected {
+				t.Fatalf("Expected span %d at level %d after rank %d, got %d", expected, i, ranks[x], x.level[i].span)
+			}
+		}
+	}
+}
+
+func TestList_BulkLoad(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	elements := make([]Element, 0, 2000)
+	expected := make([]interface{}, 0, 2000)
+	for i := 0; i < 2000; i++ {
+		elements = append(elements, Element{Score: float64(i / 3), Member: fmt.Sprintf("m%04d", i)})
+		expected = append(expected, elements[i].Member)
+	}
+
+	sl := New()
+	sl.Add(5, "stale")
+	if err := sl.BulkLoad(elements); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	checkRanks(t, sl, expected)
+	checkSpans(t, sl)
+
+	// The loaded list keeps working with regular operations
+	for i := 0; i < 500; i++ {
+		e := elements[r.Intn(len(elements))]
+		if sl.Remove(e.Score, e.Member) {
+			sl.Add(e.Score, e.Member)
+		}
+	}
+	sl.Add(-1, "first")
+	if sl.GetByRank(1).obj != "first" || sl.GetByRank(2).backward.obj != "first" {
+		t.Errorf("Unexpected head after adding to a bulk loaded list")
+	}
+	checkSpans(t, sl)
+
+	if err := sl.BulkLoad(nil); err != nil || !sl.IsEmpty() || sl.tail != nil {
+		t.Errorf("Expected loading nothing to empty the list, got %v %v", err, sl)
+	}
+}
+
+func TestList_BulkLoad_Errors(t *testing.T) {
+	sl := New()
+	sl.Add(1, "keep")
+
+	if err := sl.BulkLoad([]Element{{1, "b"}, {1, "a"}}); !errors.Is(err, ErrNotSorted) {
+		t.Errorf("Expected ErrNotSorted, got %v", err)
+	}
+	if err := sl.BulkLoad([]Element{{2, "a"}, {1, "b"}}); !errors.Is(err, ErrNotSorted) {
+		t.Errorf("Expected ErrNotSorted, got %v", err)
+	}
+	if err := sl.BulkLoad([]Element{{math.NaN(), "a"}}); !errors.Is(err, ErrNaNScore) {
+		t.Errorf("Expected ErrNaNScore, got %v", err)
+	}
+	if sl.Size() != 1 || !sl.Contains(1, "keep") {
+		t.Errorf("Expected the list to be untouched after a failed load, got %v", sl)
+	}
+}
+
+func TestList_WriteToReadFrom(t *testing.T) {
+	sl := New()
+	sl.Add(math.Inf(-1), "low")
+	sl.Add(1.5, "a")
+	sl.Add(1.5, "b")
+	sl.Add(math.Inf(1), "high")
+
+	var buf bytes.Buffer
+	written, err := sl.WriteTo(&buf)
+	if err != nil || written != int64(buf.Len()) {
+		t.Fatalf("Unexpected WriteTo result %d %v", written, err)
+	}
+	// Data after the snapshot is left in the reader
+	buf.WriteString("trailer")
+
+	decoded := NewWithOptions(4, 0.25, nil)
+	decoded.Add(9, "stale")
+	read, err := decoded.ReadFrom(&buf)
+	if err != nil || read != written {
+		t.Fatalf("Unexpected ReadFrom result %d %v", read, err)
+	}
+	if buf.String() != "trailer" {
+		t.Errorf("Expected ReadFrom to stop at the end of the snapshot, left %q", buf.String())
+	}
+	if decoded.String() != sl.String() {
+		t.Errorf("Expected %v after round trip, got %v", sl, decoded)
+	}
+	if decoded.levels.maxLevel != 4 {
+		t.Errorf("Expected ReadFrom to keep the level distribution, got %d", decoded.levels.maxLevel)
+	}
+	checkSpans(t, decoded)
+
+	// JSONCodec decodes numbers as float64
+	numbers := New()
+	numbers.Add(1, 42)
+	buf.Reset()
+	_, _ = numbers.WriteTo(&buf)
+	_, _ = decoded.ReadFrom(&buf)
+	if node := decoded.GetByRank(1); node == nil || node.obj != float64(42) {
+		t.Errorf("Expected member 42 as float64, got %v", node)
+	}
+}
+
+func TestList_WriteToWithCodec(t *testing.T) {
+	sl := New()
+	sl.Add(2, "b")
+	sl.Add(1, "a")
+
+	var buf bytes.Buffer
+	if _, err := sl.WriteToWithCodec(&buf, StringCodec{}); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	// Header, count, then score bits, length and raw bytes of each member
+	if buf.Len() != 4+8+2*(8+4+1) {
+		t.Errorf("Unexpected snapshot size %d", buf.Len())
+	}
+
+	decoded := New()
+	if _, err := decoded.ReadFromWithCodec(&buf, StringCodec{}); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(decoded.elements(), []Element{{1, "a"}, {2, "b"}}) {
+		t.Errorf("Unexpected elements %v", decoded.elements())
+	}
+
+	sl.Add(3, 3)
+	if _, err := sl.WriteToWithCodec(&buf, StringCodec{}); err == nil {
+		t.Errorf("Expected StringCodec to reject a non-string member")
+	}
+}
+
+func TestList_ReadFrom_Invalid(t *testing.T) {
+	sl := New()
+	sl.Add(1, "a")
+	sl.Add(2, "b")
+	var buf bytes.Buffer
+	_, _ = sl.WriteTo(&buf)
+	snapshot := buf.Bytes()
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"bad magic", append([]byte("XXXX"), snapshot[4:]...)},
+		{"truncated header", snapshot[:8]},
+		{"truncated element", snapshot[:len(snapshot)-1]},
+	}
+
+	for _, tt := range tests {
+		decoded := New()
+		decoded.Add(5, "keep")
+		if _, err := decoded.ReadFrom(bytes.NewReader(tt.data)); !errors.Is(err, ErrInvalidSnapshot) {
+			t.Errorf("%s: expected ErrInvalidSnapshot, got %v", tt.name, err)
+		}
+		if decoded.Size() != 1 {
+			t.Errorf("%s: expected the list to be untouched, got %v", tt.name, decoded)
+		}
+	}
+}
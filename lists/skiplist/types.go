@@ -4,19 +4,48 @@
 
 package skiplist
 
+import (
+	"cmp"
+	"io"
+	"math/rand"
+	"sync"
+)
+
 // List represents the skip list structure itself
 type List struct {
-	header *Node  // Pointer to the header node (level 0)
-	tail   *Node  // Pointer to the tail node (level 0)
-	length uint64 // Number of nodes in the skip list
-	level  int    // Maximum level in the skip list
+	header  *Node                 // Pointer to the header node (level 0)
+	tail    *Node                 // Pointer to the tail node (level 0)
+	length  uint64                // Number of nodes in the skip list
+	level   int                   // Maximum level in the skip list
+	version uint64                // Incremented on every change of the list, lets iterators detect it
+	levels  levelGenerator        // Generates the levels of new nodes
+	members map[interface{}]*Node // Node of every member, only set for lists created with NewUnique
+}
+
+// levelGenerator draws random node levels following a geometric distribution
+type levelGenerator struct {
+	maxLevel    int        // Maximum level of a node
+	probability float64    // Probability for a node to get one more level
+	rng         *rand.Rand // Source of randomness, nil to use the global math/rand source
+}
+
+// countingWriter counts the bytes written to w
+type countingWriter struct {
+	w io.Writer // Underlying writer
+	n int64     // Number of bytes written so far
+}
+
+// countingReader counts the bytes read from r
+type countingReader struct {
+	r io.Reader // Underlying reader
+	n int64     // Number of bytes read so far
 }
 
 // Node represents a node in the skip list
 type Node struct {
 	// forward stores the pointers to the next nodes at each level
 	level []*nodeLevel
-	// backward stores the pointer to the previous node
+	// backward stores the pointer to the previous node (nil for the first node)
 	backward *Node
 	// score represents the score of the node (typically used for ordered data)
 	score float64
@@ -30,6 +59,16 @@ type nodeLevel struct {
 	span    int   // Span represents the number of nodes between this and the next node at this nodeLevel
 }
 
+// Iterator walks a skip list in both directions
+// It is invalidated by any change of the list made after it was positioned.
+type Iterator struct {
+	list    *List  // List being iterated
+	node    *Node  // Current node, nil before the first and after the last node
+	atEnd   bool   // Whether the iterator moved past the last node
+	version uint64 // Version of the list when the iterator was positioned
+	err     error  // Error that stopped the iteration
+}
+
 func (n *Node) GetScore() float64 {
 	return n.score
 }
@@ -37,3 +76,77 @@ func (n *Node) GetScore() float64 {
 func (n *Node) GetObj() interface{} {
 	return n.obj
 }
+
+// ScoreRange represents an interval of scores, each bound is inclusive unless marked exclusive
+type ScoreRange struct {
+	Min, Max                   float64 // Bounds of the interval, use math.Inf for open ends
+	MinExclusive, MaxExclusive bool    // Whether the corresponding bound is excluded
+}
+
+// LexBound represents one end of a lexicographic range of members
+type LexBound struct {
+	Value     interface{} // Bound value, compared with utils.CompareObjects
+	Exclusive bool        // Whether Value itself is excluded
+	Infinite  bool        // Whether the bound is open ("-" or "+" in Redis), Value is then ignored
+}
+
+// LexRange represents an interval of members, meaningful when all nodes share the same score
+type LexRange struct {
+	Min, Max LexBound // Lower and upper bounds of the interval
+}
+
+// Element is a score/member pair, used to exchange elements with the skip list
+type Element struct {
+	Score  float64     `json:"score"`  // Score of the node
+	Member interface{} `json:"member"` // Object stored in the node
+}
+
+// SortedSet represents a Redis-style sorted set
+// It pairs a skip list ordered by score with a member -> score dictionary,
+// so that members can be looked up, updated and ranked by member alone.
+type SortedSet struct {
+	list *List                   // Elements ordered by score, then member
+	dict map[interface{}]float64 // Score of every member
+}
+
+// BlockingList wraps a skip list to make it safe for concurrent use
+// Consumers can block until an element is available, like BZPOPMIN and BZPOPMAX in Redis.
+type BlockingList struct {
+	mu    sync.Mutex    // Guards list and ready
+	list  *List         // Wrapped skip list
+	ready chan struct{} // Closed when an element is added, nil while no consumer waits
+}
+
+// Generic is a skip list with typed scores and members
+// Members with equal scores are ordered by the comparator given to NewGeneric,
+// so no reflection or string formatting happens on the hot path.
+type Generic[S cmp.Ordered, M any] struct {
+	header  *GenericNode[S, M] // Pointer to the header node
+	tail    *GenericNode[S, M] // Pointer to the tail node
+	length  int                // Number of nodes in the skip list
+	level   int                // Maximum level in the skip list
+	compare func(a, b M) int   // Orders members with equal scores, returns <0, 0 or >0
+	levels  levelGenerator     // Generates the levels of new nodes
+}
+
+// GenericNode represents a node in a generic skip list
+type GenericNode[S cmp.Ordered, M any] struct {
+	level    []genericLevel[S, M] // Forward pointers and spans at each level
+	backward *GenericNode[S, M]   // Previous node, nil for the first node
+	score    S                    // Score of the node
+	member   M                    // Member stored in the node
+}
+
+// genericLevel represents a level of a generic node with its forward pointer and span
+type genericLevel[S cmp.Ordered, M any] struct {
+	forward *GenericNode[S, M] // Pointer to the next node at this level
+	span    int                // Number of nodes between this and the next node at this level
+}
+
+// GenericScoreRange represents an interval of typed scores
+// A bound marked unbounded is ignored, which plays the role of math.Inf in ScoreRange.
+type GenericScoreRange[S cmp.Ordered] struct {
+	Min, Max                   S    // Bounds of the interval
+	MinExclusive, MaxExclusive bool // Whether the corresponding bound is excluded
+	MinUnbounded, MaxUnbounded bool // Whether the corresponding side has no bound
+}
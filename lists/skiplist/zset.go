@@ -0,0 +1,258 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// Reference: https://redis.io/docs/latest/develop/data-types/sorted-sets/
+
+package skiplist
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// NewSortedSet creates a new empty sorted set
+// Members are matched with ==, both in the dictionary and in the skip list built with NewUnique.
+func NewSortedSet() *SortedSet {
+	return &SortedSet{
+		list: NewUnique(),
+		dict: make(map[interface{}]float64),
+	}
+}
+
+// ZAdd adds members with their scores, or updates the scores of existing members
+// It returns the number of added members, or the number of added and updated members if ZAddCH is set.
+// Members are used as map keys, so they must be comparable, otherwise ErrUnhashableMember is returned.
+func (z *SortedSet) ZAdd(flags ZAddFlag, elements ...Element) (int, error) {
+	nx, xx := flags&ZAddNX != 0, flags&ZAddXX != 0
+	gt, lt := flags&ZAddGT != 0, flags&ZAddLT != 0
+
+	// Reject flag combinations that contradict each other, like Redis does
+	if (nx && (xx || gt || lt)) || (gt && lt) {
+		return 0, ErrIncompatibleFlags
+	}
+	// Validate every element before touching the set so that a failed call changes nothing
+	for _, e := range elements {
+		if math.IsNaN(e.Score) {
+			return 0, ErrNaNScore
+		}
+		if !hashable(e.Member) {
+			return 0, ErrUnhashableMember
+		}
+	}
+
+	added, updated := 0, 0
+	for _, e := range elements {
+		current, exists := z.dict[e.Member]
+		if !exists {
+			if xx {
+				continue
+			}
+			z.insert(e.Score, e.Member)
+			added++
+			continue
+		}
+
+		if nx || (gt && e.Score <= current) || (lt && e.Score >= current) {
+			continue
+		}
+		if e.Score != current {
+			z.update(current, e.Score, e.Member)
+			updated++
+		}
+	}
+
+	if flags&ZAddCH != 0 {
+		return added + updated, nil
+	}
+	return added, nil
+}
+
+// ZIncrBy increments the score of member by increment and returns the new score
+// A missing member is added with increment as its score.
+func (z *SortedSet) ZIncrBy(increment float64, member interface{}) (float64, error) {
+	if !hashable(member) {
+		return 0, ErrUnhashableMember
+	}
+	current, exists := z.dict[member]
+	score := current + increment
+	if math.IsNaN(score) {
+		return 0, ErrNaNScore
+	}
+
+	if exists {
+		z.update(current, score, member)
+	} else {
+		z.insert(score, member)
+	}
+	return score, nil
+}
+
+// ZRem removes the given members and returns the number of members actually removed
+func (z *SortedSet) ZRem(members ...interface{}) int {
+	removed := 0
+	for _, member := range members {
+		score, exists := z.ZScore(member)
+		if !exists {
+			continue
+		}
+		z.list.Remove(score, member)
+		delete(z.dict, member)
+		removed++
+	}
+	return removed
+}
+
+// ZRemRangeByScore removes the members whose score is within r and returns how many were removed
+func (z *SortedSet) ZRemRangeByScore(r ScoreRange) int {
+	return z.list.RemoveRangeByScore(r, z.forget)
+}
+
+// ZRemRangeByRank removes the members between the 0-based ranks start and stop, both inclusive,
+// and returns how many were removed. Negative indexes count from the highest score.
+func (z *SortedSet) ZRemRangeByRank(start, stop int) int {
+	return z.list.RemoveRangeByRank(start, stop, z.forget)
+}
+
+// ZRemRangeByLex removes the members within the lexicographic range r and returns how many were removed
+// It is only meaningful when all members share the same score.
+func (z *SortedSet) ZRemRangeByLex(r LexRange) int {
+	return z.list.RemoveRangeByLex(r, z.forget)
+}
+
+// ZPopMin removes and returns up to count members with the lowest scores, from the lowest score
+func (z *SortedSet) ZPopMin(count int) []Element {
+	return z.forgetAll(z.list.PopMin(count))
+}
+
+// ZPopMax removes and returns up to count members with the highest scores, from the highest score
+func (z *SortedSet) ZPopMax(count int) []Element {
+	return z.forgetAll(z.list.PopMax(count))
+}
+
+// ZScore returns the score of member
+// The second return value is false if the member is not in the set
+func (z *SortedSet) ZScore(member interface{}) (float64, bool) {
+	// A member that cannot be a map key cannot be in the set
+	if !hashable(member) {
+		return 0, false
+	}
+	score, exists := z.dict[member]
+	return score, exists
+}
+
+// ZRank returns the 0-based rank of member, ordered from the lowest to the highest score
+// It returns -1 if the member is not in the set
+func (z *SortedSet) ZRank(member interface{}) int {
+	score, exists := z.ZScore(member)
+	if !exists {
+		return -1
+	}
+	// Rank of the underlying skip list is 1-based
+	return z.list.Rank(score, member) - 1
+}
+
+// ZRevRank returns the 0-based rank of member, ordered from the highest to the lowest score
+// It returns -1 if the member is not in the set
+func (z *SortedSet) ZRevRank(member interface{}) int {
+	rank := z.ZRank(member)
+	if rank < 0 {
+		return -1
+	}
+	return z.ZCard() - 1 - rank
+}
+
+// ZRangeByRank returns the members between the 0-based ranks start and stop, both inclusive, with their scores
+// Negative indexes count from the highest score, like Redis ZRANGE.
+func (z *SortedSet) ZRangeByRank(start, stop int) []Element {
+	return nodeElements(z.list.RangeByRank(start, stop))
+}
+
+// ZRevRangeByRank returns the members between the 0-based ranks start and stop counted from the highest score
+func (z *SortedSet) ZRevRangeByRank(start, stop int) []Element {
+	return nodeElements(z.list.RevRangeByRank(start, stop))
+}
+
+// ZRangeByScore returns the members whose score is within r, from the lowest score
+// offset and limit behave as in List.RangeByScore.
+func (z *SortedSet) ZRangeByScore(r ScoreRange, offset, limit int) []Element {
+	return nodeElements(z.list.RangeByScore(r, offset, limit))
+}
+
+// ZRevRangeByScore returns the members whose score is within r, from the highest score
+func (z *SortedSet) ZRevRangeByScore(r ScoreRange, offset, limit int) []Element {
+	return nodeElements(z.list.RevRangeByScore(r, offset, limit))
+}
+
+// ZRangeByLex returns the members within the lexicographic range r, in ascending order
+// It is only meaningful when all members share the same score.
+func (z *SortedSet) ZRangeByLex(r LexRange, offset, limit int) []Element {
+	return nodeElements(z.list.RangeByLex(r, offset, limit))
+}
+
+// ZRevRangeByLex returns the members within the lexicographic range r, in descending order
+func (z *SortedSet) ZRevRangeByLex(r LexRange, offset, limit int) []Element {
+	return nodeElements(z.list.RevRangeByLex(r, offset, limit))
+}
+
+// ZCount returns the number of members whose score is within r
+func (z *SortedSet) ZCount(r ScoreRange) int {
+	return z.list.CountInScore(r)
+}
+
+// ZLexCount returns the number of members within the lexicographic range r
+func (z *SortedSet) ZLexCount(r LexRange) int {
+	return z.list.CountByLex(r)
+}
+
+// ZCard returns the number of members in the set
+func (z *SortedSet) ZCard() int {
+	return len(z.dict)
+}
+
+// String returns a string representation of the sorted set
+func (z *SortedSet) String() string {
+	// Create a slice to hold string representations of the elements
+	var elements []string
+	for x := z.list.header.level[0].forward; x != nil; x = x.level[0].forward {
+		elements = append(elements, fmt.Sprintf("%v: %v", x.obj, x.score))
+	}
+
+	// Join all the elements with commas and wrap them in square brackets
+	return fmt.Sprintf("SortedSet elements: [%s]", strings.Join(elements, ", "))
+}
+
+// nodeElements converts nodes into score/member pairs
+func nodeElements(nodes []*Node) []Element {
+	elements := make([]Element, 0, len(nodes))
+	for _, node := range nodes {
+		elements = append(elements, Element{Score: node.score, Member: node.obj})
+	}
+	return elements
+}
+
+// insert adds a member that is not in the set yet
+func (z *SortedSet) insert(score float64, member interface{}) {
+	z.list.Add(score, member)
+	z.dict[member] = score
+}
+
+// forget drops the member of a node removed from the skip list from the dictionary
+func (z *SortedSet) forget(node *Node) {
+	delete(z.dict, node.obj)
+}
+
+// forgetAll drops the members of popped elements from the dictionary and returns the elements
+func (z *SortedSet) forgetAll(elements []Element) []Element {
+	for _, e := range elements {
+		delete(z.dict, e.Member)
+	}
+	return elements
+}
+
+// update moves an existing member from its current score to a new one
+func (z *SortedSet) update(current, score float64, member interface{}) {
+	z.list.UpdateScore(current, member, score)
+	z.dict[member] = score
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSortedSet_ZAdd(t *testing.T) {
+	z := NewSortedSet()
+
+	added, err := z.ZAdd(0, Element{Score: 1, Member: "a"}, Element{Score: 2, Member: "b"})
+	if err != nil || added != 2 {
+		t.Fatalf("Expected 2 added members, got %d (%v)", added, err)
+	}
+
+	// Updating an existing member does not count as an addition
+	added, _ = z.ZAdd(0, Element{Score: 5, Member: "a"}, Element{Score: 3, Member: "c"})
+	if added != 1 {
+		t.Errorf("Expected 1 added member, got %d", added)
+	}
+	if score, ok := z.ZScore("a"); !ok || score != 5 {
+		t.Errorf("Expected a to have score 5, got %v (%v)", score, ok)
+	}
+	if z.ZCard() != 3 || z.list.Size() != 3 {
+		t.Errorf("Expected 3 members, got %d in dict and %d in list", z.ZCard(), z.list.Size())
+	}
+	if z.list.Contains(1, "a") || !z.list.Contains(5, "a") {
+		t.Errorf("Expected the old score of a to be replaced in the skip list")
+	}
+}
+
+func TestSortedSet_ZAddFlags(t *testing.T) {
+	z := NewSortedSet()
+	_, _ = z.ZAdd(0, Element{Score: 10, Member: "a"})
+
+	// NX never updates
+	added, _ := z.ZAdd(ZAddNX, Element{Score: 1, Member: "a"}, Element{Score: 1, Member: "b"})
+	if score, _ := z.ZScore("a"); added != 1 || score != 10 {
+		t.Errorf("NX: expected 1 added and a=10, got %d and a=%v", added, score)
+	}
+
+	// XX never adds
+	added, _ = z.ZAdd(ZAddXX, Element{Score: 20, Member: "a"}, Element{Score: 1, Member: "c"})
+	if score, _ := z.ZScore("a"); added != 0 || score != 20 || z.ZCard() != 2 {
+		t.Errorf("XX: expected 0 added and a=20, got %d and a=%v", added, score)
+	}
+
+	// GT only raises scores, LT only lowers them
+	_, _ = z.ZAdd(ZAddGT, Element{Score: 15, Member: "a"})
+	if score, _ := z.ZScore("a"); score != 20 {
+		t.Errorf("GT: expected a to stay 20, got %v", score)
+	}
+	_, _ = z.ZAdd(ZAddGT, Element{Score: 25, Member: "a"})
+	if score, _ := z.ZScore("a"); score != 25 {
+		t.Errorf("GT: expected a to become 25, got %v", score)
+	}
+	_, _ = z.ZAdd(ZAddLT, Element{Score: 30, Member: "a"})
+	if score, _ := z.ZScore("a"); score != 25 {
+		t.Errorf("LT: expected a to stay 25, got %v", score)
+	}
+
+	// GT still adds new members
+	added, _ = z.ZAdd(ZAddGT, Element{Score: 1, Member: "d"})
+	if added != 1 {
+		t.Errorf("GT: expected new member to be added, got %d", added)
+	}
+
+	// CH counts updated members too, but not members whose score is unchanged
+	changed, _ := z.ZAdd(ZAddCH, Element{Score: 0, Member: "a"}, Element{Score: 1, Member: "b"}, Element{Score: 7, Member: "e"})
+	if changed != 2 {
+		t.Errorf("CH: expected 2 changed members, got %d", changed)
+	}
+}
+
+func TestSortedSet_ZAddErrors(t *testing.T) {
+	z := NewSortedSet()
+	for _, flags := range []ZAddFlag{ZAddNX | ZAddXX, ZAddNX | ZAddGT, ZAddNX | ZAddLT, ZAddGT | ZAddLT} {
+		if _, err := z.ZAdd(flags, Element{Score: 1, Member: "a"}); err != ErrIncompatibleFlags {
+			t.Errorf("Expected ErrIncompatibleFlags for flags %b, got %v", flags, err)
+		}
+	}
+
+	// A NaN score rejects the whole call
+	_, err := z.ZAdd(0, Element{Score: 1, Member: "a"}, Element{Score: math.NaN(), Member: "b"})
+	if err != ErrNaNScore || z.ZCard() != 0 {
+		t.Errorf("Expected ErrNaNScore and no change, got %v and %d members", err, z.ZCard())
+	}
+
+	// An unhashable member rejects the whole call instead of panicking on the map write
+	_, err = z.ZAdd(0, Element{Score: 1, Member: "a"}, Element{Score: 2, Member: []int{1}})
+	if err != ErrUnhashableMember || z.ZCard() != 0 {
+		t.Errorf("Expected ErrUnhashableMember and no change, got %v and %d members", err, z.ZCard())
+	}
+	if _, err := z.ZIncrBy(1, map[string]int{}); err != ErrUnhashableMember {
+		t.Errorf("Expected ErrUnhashableMember from ZIncrBy, got %v", err)
+	}
+	if z.ZRem([]int{1}) != 0 || z.ZRank([]int{1}) != -1 {
+		t.Errorf("Expected an unhashable member to be absent")
+	}
+}
+
+func TestSortedSet_ZIncrBy(t *testing.T) {
+	z := NewSortedSet()
+
+	if score, err := z.ZIncrBy(5, "a"); err != nil || score != 5 {
+		t.Errorf("Expected new member with score 5, got %v (%v)", score, err)
+	}
+	if score, err := z.ZIncrBy(-2, "a"); err != nil || score != 3 {
+		t.Errorf("Expected score 3, got %v (%v)", score, err)
+	}
+	if z.ZCard() != 1 || !z.list.Contains(3, "a") || z.list.Size() != 1 {
+		t.Errorf("Expected a single member a=3, got %v", z)
+	}
+
+	_, _ = z.ZIncrBy(math.Inf(1), "b")
+	if _, err := z.ZIncrBy(math.Inf(-1), "b"); err != ErrNaNScore {
+		t.Errorf("Expected ErrNaNScore for inf + -inf, got %v", err)
+	}
+}
+
+func TestSortedSet_ZRem(t *testing.T) {
+	z := NewSortedSet()
+	_, _ = z.ZAdd(0, Element{Score: 1, Member: "a"}, Element{Score: 2, Member: "b"}, Element{Score: 3, Member: "c"})
+
+	if removed := z.ZRem("a", "c", "missing"); removed != 2 {
+		t.Errorf("Expected 2 removed members, got %d", removed)
+	}
+	if z.ZCard() != 1 || z.list.Size() != 1 {
+		t.Errorf("Expected 1 member left, got %d", z.ZCard())
+	}
+	if _, ok := z.ZScore("a"); ok {
+		t.Errorf("Expected a to be removed")
+	}
+}
+
+func TestSortedSet_MemberEquality(t *testing.T) {
+	// 1 and "1" are two members, even though they print the same
+	z := NewSortedSet()
+	_, _ = z.ZAdd(0, Element{Score: 1, Member: "1"}, Element{Score: 1, Member: 1})
+
+	if r1, r2 := z.ZRank(1), z.ZRank("1"); r1+r2 != 1 || r1 == r2 {
+		t.Errorf("Expected ranks 0 and 1, got %d and %d", r1, r2)
+	}
+	if removed := z.ZRem("1"); removed != 1 {
+		t.Fatalf("Expected 1 removed member, got %d", removed)
+	}
+	if z.ZCard() != 1 || z.list.Size() != 1 || !z.list.Contains(1, 1) || z.list.Contains(1, "1") {
+		t.Errorf("Expected only int 1 to remain, got %v", z)
+	}
+	if _, err := z.ZAdd(0, Element{Score: 5, Member: "1"}, Element{Score: 3, Member: 1}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := z.ZIncrBy(1, 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if score, _ := z.ZScore("1"); score != 5 || !z.list.Contains(5, "1") || !z.list.Contains(4, 1) {
+		t.Errorf("Expected \"1\"=5 and 1=4, got %v", z)
+	}
+	if z.ZCard() != z.list.Size() {
+		t.Errorf("Expected the dictionary and the list to agree, got %d and %d", z.ZCard(), z.list.Size())
+	}
+}
+
+func TestSortedSet_ZRank(t *testing.T) {
+	z := NewSortedSet()
+	_, _ = z.ZAdd(0,
+		Element{Score: 30, Member: "carol"},
+		Element{Score: 10, Member: "alice"},
+		Element{Score: 20, Member: "bob"},
+		Element{Score: 20, Member: "bart"},
+	)
+
+	expected := map[string]int{"alice": 0, "bart": 1, "bob": 2, "carol": 3}
+	for member, rank := range expected {
+		if got := z.ZRank(member); got != rank {
+			t.Errorf("Expected rank %d for %s, got %d", rank, member, got)
+		}
+		if got := z.ZRevRank(member); got != 3-rank {
+			t.Errorf("Expected reverse rank %d for %s, got %d", 3-rank, member, got)
+		}
+	}
+	if z.ZRank("missing") != -1 || z.ZRevRank("missing") != -1 {
+		t.Errorf("Expected -1 for a missing member")
+	}
+
+	// Moving a member updates its rank
+	_, _ = z.ZIncrBy(100, "alice")
+	if z.ZRank("alice") != 3 || z.ZRevRank("alice") != 0 {
+		t.Errorf("Expected alice to move to the top, got rank %d", z.ZRank("alice"))
+	}
+}
+
+func TestSortedSet_String(t *testing.T) {
+	z := NewSortedSet()
+	_, _ = z.ZAdd(0, Element{Score: 2, Member: "b"}, Element{Score: 1, Member: "a"})
+	expected := "SortedSet elements: [a: 1, b: 2]"
+	if z.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, z.String())
+	}
+}
+
+func TestSortedSet_ZPopMinMax(t *testing.T) {
+	z := NewSortedSet()
+	_, _ = z.ZAdd(0, Element{1, "a"}, Element{2, "b"}, Element{3, "c"})
+
+	if got := z.ZPopMin(1); !reflect.DeepEqual(got, []Element{{1, "a"}}) {
+		t.Errorf("ZPopMin(1): unexpected %v", got)
+	}
+	if got := z.ZPopMax(1); !reflect.DeepEqual(got, []Element{{3, "c"}}) {
+		t.Errorf("ZPopMax(1): unexpected %v", got)
+	}
+	if _, ok := z.ZScore("a"); ok || z.ZCard() != 1 || z.ZRank("b") != 0 {
+		t.Errorf("Expected only b to remain, got %v", z)
+	}
+}
+
+func TestSortedSet_Ranges(t *testing.T) {
+	z := NewSortedSet()
+	_, _ = z.ZAdd(0, Element{1, "a"}, Element{2, "b"}, Element{3, "c"}, Element{3, "d"})
+
+	if got := z.ZRangeByRank(1, 2); !reflect.DeepEqual(got, []Element{{2, "b"}, {3, "c"}}) {
+		t.Errorf("ZRangeByRank: unexpected %v", got)
+	}
+	if got := z.ZRevRangeByRank(0, 0); !reflect.DeepEqual(got, []Element{{3, "d"}}) {
+		t.Errorf("ZRevRangeByRank: unexpected %v", got)
+	}
+	if got := z.ZRangeByScore(ScoreRange{Min: 2, Max: 3}, 1, 5); !reflect.DeepEqual(got, []Element{{3, "c"}, {3, "d"}}) {
+		t.Errorf("ZRangeByScore: unexpected %v", got)
+	}
+	if got := z.ZRevRangeByScore(ScoreRange{Min: 1, Max: 2}, 0, -1); !reflect.DeepEqual(got, []Element{{2, "b"}, {1, "a"}}) {
+		t.Errorf("ZRevRangeByScore: unexpected %v", got)
+	}
+	if got := z.ZRangeByRank(10, 20); len(got) != 0 {
+		t.Errorf("Expected an empty range, got %v", got)
+	}
+
+	lex := LexRange{Min: LexBound{Value: "c"}, Max: LexBound{Infinite: true}}
+	if got := z.ZRangeByLex(lex, 0, -1); !reflect.DeepEqual(got, []Element{{3, "c"}, {3, "d"}}) {
+		t.Errorf("ZRangeByLex: unexpected %v", got)
+	}
+	if got := z.ZRevRangeByLex(lex, 0, 1); !reflect.DeepEqual(got, []Element{{3, "d"}}) {
+		t.Errorf("ZRevRangeByLex: unexpected %v", got)
+	}
+	if z.ZCount(ScoreRange{Min: 2, Max: math.Inf(1)}) != 3 || z.ZLexCount(lex) != 2 {
+		t.Errorf("Unexpected counts")
+	}
+}
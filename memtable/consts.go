@@ -0,0 +1,30 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package memtable
+
+import "errors"
+
+// Default values for the tables written by Flush
+const (
+	DefaultBlockSize         = 4 << 10 // Target size of a data block in bytes
+	DefaultFalsePositiveRate = 0.01    // False positive rate of the embedded Bloom Filter
+)
+
+// entryOverhead approximates the memory used by an entry on top of its key and value,
+// the skip list node with its levels, the Entry struct and the slice headers.
+const entryOverhead = 96
+
+// Layout of an SSTable
+const (
+	tableMagic     uint64 = 0x67646d656d746231 // "gdmemtb1", ends every table
+	footerSize            = 6 * 8              // Index and filter handles, entry count and magic
+	checksumSize          = 4                  // CRC-32 following every block
+	kindValue      byte   = 0                  // Entry holding a value
+	kindTombstone  byte   = 1                  // Entry marking a deleted key
+	maxEncodedSize        = 1 << 30            // Largest block accepted when reading a table
+)
+
+// ErrCorruptTable is returned when a table is truncated or fails its checksums
+var ErrCorruptTable = errors.New("memtable: corrupt or truncated table")
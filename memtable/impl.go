@@ -0,0 +1,123 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// Reference: https://en.wikipedia.org/wiki/Log-structured_merge-tree
+
+package memtable
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethan-gao-code/go-ds/lists/skiplist"
+)
+
+// New creates a new empty memtable
+// It is not safe for concurrent use, the caller serializes writes as its write-ahead log does.
+func New() *Table {
+	return &Table{
+		list: skiplist.NewGeneric[uint8](compareEntries),
+	}
+}
+
+// compareEntries orders entries by key
+func compareEntries(a, b *Entry) int {
+	return bytes.Compare(a.Key, b.Key)
+}
+
+// Put sets the value of a key written with sequence number seq
+// It returns false and keeps the current entry if it was written with a higher seq.
+// The key and value are copied, so the caller may reuse its buffers.
+func (t *Table) Put(seq uint64, key, value []byte) bool {
+	return t.write(seq, key, bytes.Clone(value), false)
+}
+
+// Delete writes a tombstone for a key with sequence number seq
+// The tombstone hides older versions of the key in the tables flushed before.
+// It returns false and keeps the current entry if it was written with a higher seq.
+func (t *Table) Delete(seq uint64, key []byte) bool {
+	return t.write(seq, key, nil, true)
+}
+
+// Get returns the latest write of a key
+// The boolean reports whether the memtable knows the key, check Entry.Tombstone to tell a deletion.
+// The key and value of the entry are shared with the memtable and must not be modified.
+func (t *Table) Get(key []byte) (Entry, bool) {
+	node := t.list.Find(0, &Entry{Key: key})
+	if node == nil {
+		return Entry{}, false
+	}
+	return *node.GetMember(), true
+}
+
+// Scan calls fn for the entries with a key in [start, end) in key order, until fn returns false
+// A nil start or end leaves that side unbounded. Tombstones are included.
+func (t *Table) Scan(start, end []byte, fn func(entry Entry) bool) {
+	for node := t.list.Ceiling(0, &Entry{Key: start}); node != nil; node = node.Next() {
+		entry := node.GetMember()
+		if end != nil && bytes.Compare(entry.Key, end) >= 0 {
+			return
+		}
+		if !fn(*entry) {
+			return
+		}
+	}
+}
+
+// Len returns the number of entries in the memtable, tombstones included
+func (t *Table) Len() int {
+	return t.list.Size()
+}
+
+// IsEmpty checks if the memtable is empty
+func (t *Table) IsEmpty() bool {
+	return t.list.IsEmpty()
+}
+
+// ApproximateSize returns the approximate memory used by the entries in bytes
+// It is meant to decide when the memtable is full and must be flushed.
+func (t *Table) ApproximateSize() int {
+	return t.size
+}
+
+// String returns a string representation of the memtable
+func (t *Table) String() string {
+	var sb strings.Builder
+	sb.WriteString("Memtable entries: [")
+	first := true
+	t.Scan(nil, nil, func(entry Entry) bool {
+		if !first {
+			sb.WriteString(", ")
+		}
+		first = false
+		if entry.Tombstone {
+			sb.WriteString(fmt.Sprintf("%q@%d: <deleted>", entry.Key, entry.Seq))
+		} else {
+			sb.WriteString(fmt.Sprintf("%q@%d: %q", entry.Key, entry.Seq, entry.Value))
+		}
+		return true
+	})
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// write stores a value or a tombstone for key, unless a newer write exists
+func (t *Table) write(seq uint64, key, value []byte, tombstone bool) bool {
+	if node := t.list.Find(0, &Entry{Key: key}); node != nil {
+		entry := node.GetMember()
+		if entry.Seq > seq {
+			return false
+		}
+		// The key is already stored, only the value changes
+		t.size += len(value) - len(entry.Value)
+		entry.Value, entry.Seq, entry.Tombstone = value, seq, tombstone
+		return true
+	}
+
+	entry := &Entry{Key: bytes.Clone(key), Value: value, Seq: seq, Tombstone: tombstone}
+	t.list.Add(0, entry)
+	t.size += len(key) + len(value) + entryOverhead
+	return true
+}
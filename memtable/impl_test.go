@@ -0,0 +1,152 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package memtable
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// scanKeys returns the keys of the entries in [start, end) as strings
+func scanKeys(scan func(start, end []byte, fn func(entry Entry) bool), start, end []byte) []string {
+	keys := []string{}
+	scan(start, end, func(entry Entry) bool {
+		keys = append(keys, string(entry.Key))
+		return true
+	})
+	return keys
+}
+
+func TestTable_PutGet(t *testing.T) {
+	mt := New()
+	if !mt.IsEmpty() || mt.ApproximateSize() != 0 {
+		t.Errorf("Expected new memtable to be empty")
+	}
+
+	key, value := []byte("k1"), []byte("v1")
+	mt.Put(1, key, value)
+	// The memtable keeps its own copies
+	key[0], value[0] = 'x', 'x'
+
+	entry, ok := mt.Get([]byte("k1"))
+	if !ok || string(entry.Value) != "v1" || entry.Seq != 1 || entry.Tombstone {
+		t.Errorf("Unexpected entry %+v, %v", entry, ok)
+	}
+	if _, ok := mt.Get([]byte("x1")); ok {
+		t.Errorf("Expected x1 to be absent")
+	}
+
+	mt.Put(2, []byte("k1"), []byte("v2"))
+	if entry, _ := mt.Get([]byte("k1")); string(entry.Value) != "v2" || entry.Seq != 2 {
+		t.Errorf("Expected the newer write to win, got %+v", entry)
+	}
+	if mt.Len() != 1 {
+		t.Errorf("Expected 1 entry, got %d", mt.Len())
+	}
+}
+
+func TestTable_SequenceNumbers(t *testing.T) {
+	mt := New()
+	mt.Put(5, []byte("k"), []byte("new"))
+
+	if mt.Put(4, []byte("k"), []byte("old")) {
+		t.Errorf("Expected an older write to be ignored")
+	}
+	if mt.Delete(3, []byte("k")) {
+		t.Errorf("Expected an older delete to be ignored")
+	}
+	if entry, _ := mt.Get([]byte("k")); string(entry.Value) != "new" || entry.Seq != 5 {
+		t.Errorf("Unexpected entry %+v", entry)
+	}
+	// A write with the same sequence number replays the same operation
+	if !mt.Put(5, []byte("k"), []byte("new")) {
+		t.Errorf("Expected a write with the same sequence number to be applied")
+	}
+}
+
+func TestTable_Delete(t *testing.T) {
+	mt := New()
+	mt.Put(1, []byte("a"), []byte("1"))
+
+	if !mt.Delete(2, []byte("a")) || !mt.Delete(3, []byte("never-written")) {
+		t.Errorf("Expected the deletes to be applied")
+	}
+	entry, ok := mt.Get([]byte("a"))
+	if !ok || !entry.Tombstone || entry.Value != nil || entry.Seq != 2 {
+		t.Errorf("Expected a tombstone, got %+v, %v", entry, ok)
+	}
+	// Tombstones of unknown keys are kept, they hide the flushed tables
+	if entry, ok := mt.Get([]byte("never-written")); !ok || !entry.Tombstone {
+		t.Errorf("Expected a tombstone, got %+v, %v", entry, ok)
+	}
+
+	mt.Put(4, []byte("a"), []byte("back"))
+	if entry, _ := mt.Get([]byte("a")); entry.Tombstone || string(entry.Value) != "back" {
+		t.Errorf("Expected the key to be written again, got %+v", entry)
+	}
+	if mt.String() != `Memtable entries: ["a"@4: "back", "never-written"@3: <deleted>]` {
+		t.Errorf("Unexpected string %s", mt)
+	}
+}
+
+func TestTable_Scan(t *testing.T) {
+	mt := New()
+	for _, key := range []string{"d", "b", "a", "e", "c"} {
+		mt.Put(1, []byte(key), nil)
+	}
+	mt.Delete(2, []byte("c"))
+
+	tests := []struct {
+		start, end []byte
+		expected   []string
+	}{
+		{nil, nil, []string{"a", "b", "c", "d", "e"}},
+		{[]byte("b"), []byte("d"), []string{"b", "c"}},
+		{[]byte("bb"), nil, []string{"c", "d", "e"}},
+		{nil, []byte("b"), []string{"a"}},
+		{[]byte("f"), nil, []string{}},
+		{[]byte("d"), []byte("b"), []string{}},
+	}
+	for _, tt := range tests {
+		if got := scanKeys(mt.Scan, tt.start, tt.end); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("Scan(%q, %q): expected %v, got %v", tt.start, tt.end, tt.expected, got)
+		}
+	}
+
+	count := 0
+	mt.Scan(nil, nil, func(Entry) bool {
+		count++
+		return count < 2
+	})
+	if count != 2 {
+		t.Errorf("Expected Scan to stop when fn returns false, got %d calls", count)
+	}
+}
+
+func TestTable_ApproximateSize(t *testing.T) {
+	mt := New()
+	mt.Put(1, []byte("key"), []byte("value"))
+	size := mt.ApproximateSize()
+	if size != 3+5+entryOverhead {
+		t.Errorf("Expected size %d, got %d", 3+5+entryOverhead, size)
+	}
+
+	mt.Put(2, []byte("key"), []byte("longer value"))
+	if got := mt.ApproximateSize(); got != size+7 {
+		t.Errorf("Expected size %d after growing the value, got %d", size+7, got)
+	}
+	mt.Delete(3, []byte("key"))
+	if got := mt.ApproximateSize(); got != 3+entryOverhead {
+		t.Errorf("Expected size %d after the delete, got %d", 3+entryOverhead, got)
+	}
+
+	for i := 0; i < 100; i++ {
+		mt.Put(uint64(i), []byte(fmt.Sprintf("key%03d", i)), make([]byte, 100))
+	}
+	if got := mt.ApproximateSize(); got < 100*106 {
+		t.Errorf("Expected the size to grow with the entries, got %d", got)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package memtable
+
+import "io"
+
+// MemTable defines the interface for an in-memory table of an LSM tree
+type MemTable interface {
+	Put(seq uint64, key, value []byte) bool            // Sets the value of a key, unless a newer write exists
+	Delete(seq uint64, key []byte) bool                // Writes a tombstone for a key, unless a newer write exists
+	Get(key []byte) (Entry, bool)                      // Returns the latest write of a key, possibly a tombstone
+	Scan(start, end []byte, fn func(entry Entry) bool) // Calls fn for the entries in [start, end) in key order
+	Len() int                                          // Returns the number of entries, tombstones included
+	ApproximateSize() int                              // Returns the approximate memory used in bytes
+
+	Flush(w io.Writer) (int64, error)                                                      // Writes the entries as an SSTable
+	FlushWithOptions(w io.Writer, blockSize int, falsePositiveRate float64) (int64, error) // Writes the entries as an SSTable with custom options
+}
+
+// SortedStringTable defines the interface for a reader of an immutable SSTable
+type SortedStringTable interface {
+	Get(key []byte) (Entry, bool, error)                     // Returns the entry of a key, possibly a tombstone
+	MayContain(key []byte) bool                              // Checks the Bloom Filter, false means the key is absent
+	Scan(start, end []byte, fn func(entry Entry) bool) error // Calls fn for the entries in [start, end) in key order
+	Len() int                                                // Returns the number of entries, tombstones included
+}
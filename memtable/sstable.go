@@ -0,0 +1,356 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// Reference: https://github.com/google/leveldb/blob/main/doc/table_format.md
+
+package memtable
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"sort"
+
+	"github.com/ethan-gao-code/go-ds/bloomfilters"
+)
+
+// crcTable is used for the checksums of the blocks
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Flush writes the entries of the memtable to w as an SSTable with the default options
+// It returns the number of bytes written, the memtable itself is left unchanged.
+func (t *Table) Flush(w io.Writer) (int64, error) {
+	return t.FlushWithOptions(w, DefaultBlockSize, DefaultFalsePositiveRate)
+}
+
+// FlushWithOptions writes the entries of the memtable to w as an SSTable
+// blockSize: target size of a data block in bytes, a lookup reads one block (default DefaultBlockSize)
+// falsePositiveRate: false positive rate of the Bloom Filter of the keys (default DefaultFalsePositiveRate)
+// Invalid values fall back to the defaults.
+// The table holds the data blocks of the entries in key order, a Bloom Filter block,
+// an index block with the last key of every data block and a fixed-size footer.
+// Every block is followed by its CRC-32.
+func (t *Table) FlushWithOptions(w io.Writer, blockSize int, falsePositiveRate float64) (int64, error) {
+	if blockSize <= 0 {
+		blockSize = DefaultBlockSize
+	}
+	if !(falsePositiveRate > 0 && falsePositiveRate < 1) {
+		falsePositiveRate = DefaultFalsePositiveRate
+	}
+
+	tw := &tableWriter{w: w, blockSize: blockSize}
+	filter := bloomfilters.New(falsePositiveRate, t.Len())
+	var err error
+	t.Scan(nil, nil, func(entry Entry) bool {
+		filter.Add(string(entry.Key))
+		err = tw.add(entry)
+		return err == nil
+	})
+	if err == nil {
+		err = tw.finishBlock()
+	}
+	if err != nil {
+		return int64(tw.offset), err
+	}
+
+	filterData, _ := filter.MarshalBinary()
+	filterHandle, err := tw.writeBlock(filterData)
+	if err != nil {
+		return int64(tw.offset), err
+	}
+	indexHandle, err := tw.writeBlock(encodeIndex(tw.index))
+	if err != nil {
+		return int64(tw.offset), err
+	}
+
+	var footer [footerSize]byte
+	binary.BigEndian.PutUint64(footer[0:], filterHandle.offset)
+	binary.BigEndian.PutUint64(footer[8:], filterHandle.length)
+	binary.BigEndian.PutUint64(footer[16:], indexHandle.offset)
+	binary.BigEndian.PutUint64(footer[24:], indexHandle.length)
+	binary.BigEndian.PutUint64(footer[32:], uint64(t.Len()))
+	binary.BigEndian.PutUint64(footer[40:], tableMagic)
+	err = tw.write(footer[:])
+	return int64(tw.offset), err
+}
+
+// add appends an entry to the current data block, flushing the block once it is full
+func (tw *tableWriter) add(entry Entry) error {
+	tw.block = appendEntry(tw.block, entry)
+	tw.lastKey = entry.Key
+	if len(tw.block) >= tw.blockSize {
+		return tw.finishBlock()
+	}
+	return nil
+}
+
+// finishBlock writes the current data block, if any, and records it in the index
+func (tw *tableWriter) finishBlock() error {
+	if len(tw.block) == 0 {
+		return nil
+	}
+	handle, err := tw.writeBlock(tw.block)
+	if err != nil {
+		return err
+	}
+	handle.lastKey = tw.lastKey
+	tw.index = append(tw.index, handle)
+	tw.block = tw.block[:0]
+	return nil
+}
+
+// writeBlock writes data followed by its checksum and returns its location
+func (tw *tableWriter) writeBlock(data []byte) (blockHandle, error) {
+	handle := blockHandle{offset: tw.offset, length: uint64(len(data))}
+	if err := tw.write(data); err != nil {
+		return handle, err
+	}
+	var checksum [checksumSize]byte
+	binary.BigEndian.PutUint32(checksum[:], crc32.Checksum(data, crcTable))
+	return handle, tw.write(checksum[:])
+}
+
+// write writes p and counts the bytes written
+func (tw *tableWriter) write(p []byte) error {
+	n, err := tw.w.Write(p)
+	tw.offset += uint64(n)
+	return err
+}
+
+// Open opens an SSTable written by Flush, r must hold the size bytes of the table
+// It reads and checks the footer, the index and the Bloom Filter, the data blocks are read by the lookups.
+// An *os.File opened on a flushed table is a suitable r, it must stay open while the SSTable is used.
+func Open(r io.ReaderAt, size int64) (*SSTable, error) {
+	if size < footerSize {
+		return nil, ErrCorruptTable
+	}
+	var footer [footerSize]byte
+	if err := readAt(r, footer[:], size-footerSize); err != nil {
+		return nil, err
+	}
+	if binary.BigEndian.Uint64(footer[40:]) != tableMagic {
+		return nil, ErrCorruptTable
+	}
+
+	st := &SSTable{r: r, count: int(binary.BigEndian.Uint64(footer[32:]))}
+	dataEnd := uint64(size - footerSize)
+	filterHandle := blockHandle{offset: binary.BigEndian.Uint64(footer[0:]), length: binary.BigEndian.Uint64(footer[8:])}
+	indexHandle := blockHandle{offset: binary.BigEndian.Uint64(footer[16:]), length: binary.BigEndian.Uint64(footer[24:])}
+	if !filterHandle.within(dataEnd) || !indexHandle.within(dataEnd) {
+		return nil, ErrCorruptTable
+	}
+
+	filterData, err := st.readBlock(filterHandle)
+	if err != nil {
+		return nil, err
+	}
+	st.filter = &bloomfilters.BloomFilters{}
+	if err := st.filter.UnmarshalBinary(filterData); err != nil {
+		return nil, ErrCorruptTable
+	}
+
+	indexData, err := st.readBlock(indexHandle)
+	if err != nil {
+		return nil, err
+	}
+	if st.index, err = decodeIndex(indexData); err != nil {
+		return nil, err
+	}
+	for _, handle := range st.index {
+		if !handle.within(filterHandle.offset) {
+			return nil, ErrCorruptTable
+		}
+	}
+	return st, nil
+}
+
+// Get returns the entry of a key
+// The boolean reports whether the table holds the key, check Entry.Tombstone to tell a deletion.
+func (st *SSTable) Get(key []byte) (Entry, bool, error) {
+	if !st.MayContain(key) {
+		return Entry{}, false, nil
+	}
+
+	i := st.searchBlock(key)
+	if i == len(st.index) {
+		return Entry{}, false, nil
+	}
+	data, err := st.readBlock(st.index[i])
+	if err != nil {
+		return Entry{}, false, err
+	}
+	for len(data) > 0 {
+		var entry Entry
+		if entry, data, err = decodeEntry(data); err != nil {
+			return Entry{}, false, err
+		}
+		switch c := bytes.Compare(entry.Key, key); {
+		case c == 0:
+			return entry, true, nil
+		case c > 0:
+			return Entry{}, false, nil
+		}
+	}
+	return Entry{}, false, nil
+}
+
+// MayContain checks the Bloom Filter of the table
+// It returns false if the key is surely absent, true if it may be present.
+func (st *SSTable) MayContain(key []byte) bool {
+	return st.filter.Contains(string(key))
+}
+
+// Scan calls fn for the entries with a key in [start, end) in key order, until fn returns false
+// A nil start or end leaves that side unbounded. Tombstones are included.
+func (st *SSTable) Scan(start, end []byte, fn func(entry Entry) bool) error {
+	for i := st.searchBlock(start); i < len(st.index); i++ {
+		data, err := st.readBlock(st.index[i])
+		if err != nil {
+			return err
+		}
+		for len(data) > 0 {
+			var entry Entry
+			if entry, data, err = decodeEntry(data); err != nil {
+				return err
+			}
+			if bytes.Compare(entry.Key, start) < 0 {
+				continue
+			}
+			if end != nil && bytes.Compare(entry.Key, end) >= 0 {
+				return nil
+			}
+			if !fn(entry) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// Len returns the number of entries in the table, tombstones included
+func (st *SSTable) Len() int {
+	return st.count
+}
+
+// searchBlock returns the index of the first data block that may hold key or a larger one
+func (st *SSTable) searchBlock(key []byte) int {
+	return sort.Search(len(st.index), func(i int) bool {
+		return bytes.Compare(st.index[i].lastKey, key) >= 0
+	})
+}
+
+// readBlock reads a block and verifies its checksum
+func (st *SSTable) readBlock(handle blockHandle) ([]byte, error) {
+	buf := make([]byte, handle.length+checksumSize)
+	if err := readAt(st.r, buf, int64(handle.offset)); err != nil {
+		return nil, err
+	}
+	data := buf[:handle.length]
+	if binary.BigEndian.Uint32(buf[handle.length:]) != crc32.Checksum(data, crcTable) {
+		return nil, ErrCorruptTable
+	}
+	return data, nil
+}
+
+// within checks if the block and its checksum end before limit
+func (h blockHandle) within(limit uint64) bool {
+	return h.length <= maxEncodedSize && h.offset <= limit && h.length+checksumSize <= limit-h.offset
+}
+
+// appendEntry appends the encoding of an entry to dst:
+// the key length and key, the sequence number, the kind, the value length and value
+func appendEntry(dst []byte, entry Entry) []byte {
+	kind := kindValue
+	if entry.Tombstone {
+		kind = kindTombstone
+	}
+	dst = binary.AppendUvarint(dst, uint64(len(entry.Key)))
+	dst = append(dst, entry.Key...)
+	dst = binary.AppendUvarint(dst, entry.Seq)
+	dst = append(dst, kind)
+	dst = binary.AppendUvarint(dst, uint64(len(entry.Value)))
+	return append(dst, entry.Value...)
+}
+
+// decodeEntry decodes the entry at the start of data and returns the rest of data
+func decodeEntry(data []byte) (Entry, []byte, error) {
+	var entry Entry
+	key, data, ok := readBytes(data)
+	if !ok {
+		return entry, nil, ErrCorruptTable
+	}
+	seq, n := binary.Uvarint(data)
+	if n <= 0 || len(data) == n {
+		return entry, nil, ErrCorruptTable
+	}
+	kind := data[n]
+	value, data, ok := readBytes(data[n+1:])
+	if !ok || kind > kindTombstone {
+		return entry, nil, ErrCorruptTable
+	}
+
+	entry.Key, entry.Seq, entry.Tombstone = key, seq, kind == kindTombstone
+	if !entry.Tombstone {
+		entry.Value = value
+	}
+	return entry, data, nil
+}
+
+// encodeIndex encodes the last key, offset and length of every data block
+func encodeIndex(index []blockHandle) []byte {
+	var data []byte
+	for _, handle := range index {
+		data = binary.AppendUvarint(data, uint64(len(handle.lastKey)))
+		data = append(data, handle.lastKey...)
+		data = binary.AppendUvarint(data, handle.offset)
+		data = binary.AppendUvarint(data, handle.length)
+	}
+	return data
+}
+
+// decodeIndex decodes an index encoded by encodeIndex
+func decodeIndex(data []byte) ([]blockHandle, error) {
+	var index []blockHandle
+	for len(data) > 0 {
+		lastKey, rest, ok := readBytes(data)
+		if !ok {
+			return nil, ErrCorruptTable
+		}
+		offset, n := binary.Uvarint(rest)
+		if n <= 0 {
+			return nil, ErrCorruptTable
+		}
+		length, m := binary.Uvarint(rest[n:])
+		if m <= 0 {
+			return nil, ErrCorruptTable
+		}
+		index = append(index, blockHandle{lastKey: lastKey, offset: offset, length: length})
+		data = rest[n+m:]
+	}
+	return index, nil
+}
+
+// readBytes reads a length-prefixed byte string at the start of data and returns the rest of data
+func readBytes(data []byte) ([]byte, []byte, bool) {
+	length, n := binary.Uvarint(data)
+	if n <= 0 || length > uint64(len(data)-n) {
+		return nil, nil, false
+	}
+	end := n + int(length)
+	return data[n:end:end], data[end:], true
+}
+
+// readAt fills buf from offset, a table cut short is reported as ErrCorruptTable
+func readAt(r io.ReaderAt, buf []byte, offset int64) error {
+	n, err := r.ReadAt(buf, offset)
+	if n == len(buf) {
+		// ReaderAt may return io.EOF along with the last bytes
+		return nil
+	}
+	if err == nil || err == io.EOF || err == io.ErrUnexpectedEOF {
+		return ErrCorruptTable
+	}
+	return err
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package memtable
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethan-gao-code/go-ds/bloomfilters"
+)
+
+// newTestTable builds a memtable with the keys key0000..key0999, every tenth one deleted
+func newTestTable() *Table {
+	mt := New()
+	for i := 0; i < 1000; i++ {
+		key := []byte(fmt.Sprintf("key%04d", i))
+		if i%10 == 0 {
+			mt.Delete(uint64(i), key)
+		} else {
+			mt.Put(uint64(i), key, []byte(fmt.Sprintf("value%d", i)))
+		}
+	}
+	return mt
+}
+
+// flushAndOpen flushes mt into memory and opens the resulting table
+func flushAndOpen(t *testing.T, mt *Table, blockSize int) *SSTable {
+	t.Helper()
+	var buf bytes.Buffer
+	n, err := mt.FlushWithOptions(&buf, blockSize, 0.01)
+	if err != nil || n != int64(buf.Len()) {
+		t.Fatalf("Unexpected Flush result %d, %v", n, err)
+	}
+	st, err := Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	return st
+}
+
+func TestSSTable_Get(t *testing.T) {
+	mt := newTestTable()
+	st := flushAndOpen(t, mt, 256)
+
+	if st.Len() != 1000 {
+		t.Errorf("Expected 1000 entries, got %d", st.Len())
+	}
+	if len(st.index) < 10 {
+		t.Errorf("Expected several data blocks, got %d", len(st.index))
+	}
+
+	for i := 0; i < 1000; i++ {
+		key := []byte(fmt.Sprintf("key%04d", i))
+		expected, _ := mt.Get(key)
+		entry, ok, err := st.Get(key)
+		if err != nil || !ok || !reflect.DeepEqual(entry, expected) {
+			t.Fatalf("Get(%s): expected %+v, got %+v, %v, %v", key, expected, entry, ok, err)
+		}
+	}
+
+	for _, key := range []string{"", "key", "key0000a", "key1000", "zzz"} {
+		if _, ok, err := st.Get([]byte(key)); ok || err != nil {
+			t.Errorf("Get(%q): expected a miss, got %v, %v", key, ok, err)
+		}
+	}
+}
+
+func TestSSTable_MayContain(t *testing.T) {
+	st := flushAndOpen(t, newTestTable(), DefaultBlockSize)
+
+	// The table answers exactly like a Bloom Filter built from the same keys,
+	// so the filter survived the round trip through the file
+	expected := bloomfilters.New(0.01, 1000)
+	for i := 0; i < 1000; i++ {
+		expected.Add(fmt.Sprintf("key%04d", i))
+	}
+	for i := 0; i < 10000; i++ {
+		key := fmt.Sprintf("absent%d", i)
+		if st.MayContain([]byte(key)) != expected.Contains(key) {
+			t.Fatalf("MayContain(%s): expected %v", key, expected.Contains(key))
+		}
+	}
+	if !st.MayContain([]byte("key0010")) {
+		t.Errorf("Expected the filter to hold tombstones as well")
+	}
+}
+
+func TestSSTable_Scan(t *testing.T) {
+	mt := newTestTable()
+	st := flushAndOpen(t, mt, 128)
+
+	scan := func(start, end []byte, fn func(entry Entry) bool) {
+		if err := st.Scan(start, end, fn); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+	}
+	ranges := [][2][]byte{
+		{nil, nil},
+		{[]byte("key0100"), []byte("key0200")},
+		{[]byte("key0555x"), []byte("key0999")},
+		{nil, []byte("key0003")},
+		{[]byte("key0998"), nil},
+		{[]byte("zzz"), nil},
+	}
+	for _, r := range ranges {
+		expected, got := scanKeys(mt.Scan, r[0], r[1]), scanKeys(scan, r[0], r[1])
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Scan(%q, %q): expected %d keys, got %d", r[0], r[1], len(expected), len(got))
+		}
+	}
+
+	var values []string
+	scan([]byte("key0009"), nil, func(entry Entry) bool {
+		values = append(values, fmt.Sprintf("%s:%v", entry.Value, entry.Tombstone))
+		return len(values) < 3
+	})
+	if !reflect.DeepEqual(values, []string{"value9:false", ":true", "value11:false"}) {
+		t.Errorf("Unexpected scanned entries %v", values)
+	}
+}
+
+func TestSSTable_Empty(t *testing.T) {
+	st := flushAndOpen(t, New(), 0)
+	if st.Len() != 0 || len(st.index) != 0 {
+		t.Errorf("Expected an empty table")
+	}
+	if _, ok, err := st.Get([]byte("a")); ok || err != nil {
+		t.Errorf("Expected a miss, got %v, %v", ok, err)
+	}
+	if got := scanKeys(func(start, end []byte, fn func(Entry) bool) { _ = st.Scan(start, end, fn) }, nil, nil); len(got) != 0 {
+		t.Errorf("Expected no entries, got %v", got)
+	}
+}
+
+func TestSSTable_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "000001.sst")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if _, err := newTestTable().Flush(f); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	f, err = os.Open(path)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	defer f.Close()
+	info, _ := f.Stat()
+	st, err := Open(f, info.Size())
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if entry, ok, err := st.Get([]byte("key0123")); err != nil || !ok || string(entry.Value) != "value123" {
+		t.Errorf("Unexpected entry %+v, %v, %v", entry, ok, err)
+	}
+}
+
+func TestSSTable_Corrupt(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := newTestTable().FlushWithOptions(&buf, 256, 0.01); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	data := buf.Bytes()
+
+	open := func(data []byte) (*SSTable, error) {
+		return Open(bytes.NewReader(data), int64(len(data)))
+	}
+	if _, err := open(data[:len(data)-1]); !errors.Is(err, ErrCorruptTable) {
+		t.Errorf("Expected a truncated table to be rejected, got %v", err)
+	}
+	if _, err := open(data[:10]); !errors.Is(err, ErrCorruptTable) {
+		t.Errorf("Expected a short table to be rejected, got %v", err)
+	}
+
+	// A flipped bit in the index is caught when opening
+	index := bytes.Clone(data)
+	index[len(index)-footerSize-checksumSize-1] ^= 1
+	if _, err := open(index); !errors.Is(err, ErrCorruptTable) {
+		t.Errorf("Expected a corrupt index to be rejected, got %v", err)
+	}
+
+	// A flipped bit in a data block is caught when the block is read
+	block := bytes.Clone(data)
+	block[0] ^= 1
+	st, err := open(block)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if _, _, err := st.Get([]byte("key0001")); !errors.Is(err, ErrCorruptTable) {
+		t.Errorf("Expected a corrupt block to be reported, got %v", err)
+	}
+	if err := st.Scan(nil, nil, func(Entry) bool { return true }); !errors.Is(err, ErrCorruptTable) {
+		t.Errorf("Expected a corrupt block to be reported, got %v", err)
+	}
+}
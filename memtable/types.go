@@ -0,0 +1,53 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package memtable
+
+import (
+	"io"
+
+	"github.com/ethan-gao-code/go-ds/bloomfilters"
+	"github.com/ethan-gao-code/go-ds/lists/skiplist"
+)
+
+// Entry is the latest write of a key, either a value or a tombstone
+type Entry struct {
+	Key       []byte // Key of the entry
+	Value     []byte // Value of the entry, nil for a tombstone
+	Seq       uint64 // Sequence number of the write
+	Tombstone bool   // Whether the key was deleted
+}
+
+// Table is an in-memory sorted table of entries, the write buffer of an LSM tree
+// Entries are kept in a skip list ordered by key, all with the same score.
+type Table struct {
+	list *skiplist.Generic[uint8, *Entry] // Entries ordered by key
+	size int                              // Approximate memory used by the entries in bytes
+}
+
+// SSTable reads an immutable sorted string table written by Table.Flush
+// The index and the Bloom Filter are loaded in memory, data blocks are read on demand.
+type SSTable struct {
+	r      io.ReaderAt                // Source of the table
+	index  []blockHandle              // Location and last key of every data block
+	filter *bloomfilters.BloomFilters // Filter of the keys of the table
+	count  int                        // Number of entries in the table
+}
+
+// blockHandle locates a block within a table
+type blockHandle struct {
+	lastKey []byte // Largest key of the block, only set for data blocks
+	offset  uint64 // Offset of the block
+	length  uint64 // Length of the block, without its checksum
+}
+
+// tableWriter writes the blocks of a table
+type tableWriter struct {
+	w         io.Writer     // Destination of the table
+	offset    uint64        // Number of bytes written so far
+	blockSize int           // Target size of a data block
+	block     []byte        // Data block being filled
+	lastKey   []byte        // Last key added to the block
+	index     []blockHandle // Handles of the flushed data blocks
+}
@@ -0,0 +1,11 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package persistent
+
+const (
+	bitsPerLevel = 5                    // Number of hash bits consumed at each level of the trie
+	levelMask    = 1<<bitsPerLevel - 1  // Mask selecting the hash bits of a single level
+	maxShift     = 64 - 64%bitsPerLevel // Shift past which the hash is exhausted and keys collide
+)
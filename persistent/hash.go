@@ -0,0 +1,134 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package persistent
+
+import (
+	"hash/fnv"
+	"math"
+	"reflect"
+)
+
+// defaultHash hashes any comparable key
+// Common key types are hashed directly; other types are walked with reflection,
+// which is slower but agrees with ==: pointers and channels hash by identity,
+// structs and arrays by their fields, and 0 and -0 get the same hash wherever they appear.
+func defaultHash[K comparable](key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return hashString(k)
+	case int:
+		return mix(uint64(k))
+	case int8:
+		return mix(uint64(k))
+	case int16:
+		return mix(uint64(k))
+	case int32:
+		return mix(uint64(k))
+	case int64:
+		return mix(uint64(k))
+	case uint:
+		return mix(uint64(k))
+	case uint8:
+		return mix(uint64(k))
+	case uint16:
+		return mix(uint64(k))
+	case uint32:
+		return mix(uint64(k))
+	case uint64:
+		return mix(k)
+	case uintptr:
+		return mix(uint64(k))
+	case float32:
+		return hashFloat(float64(k))
+	case float64:
+		return hashFloat(k)
+	case bool:
+		if k {
+			return mix(1)
+		}
+		return mix(0)
+	}
+	return hashValue(reflect.ValueOf(any(key)))
+}
+
+// hashValue hashes a comparable value so that values equal under == get the same hash
+func hashValue(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.Invalid:
+		// A nil interface
+		return mix(0)
+	case reflect.Bool:
+		if v.Bool() {
+			return mix(1)
+		}
+		return mix(0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return mix(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return mix(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return hashFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return combine(hashFloat(real(c)), hashFloat(imag(c)))
+	case reflect.String:
+		return hashString(v.String())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		// Pointers are equal when they point to the same place, whatever it holds
+		return mix(uint64(v.Pointer()))
+	case reflect.Interface:
+		if v.IsNil() {
+			return mix(0)
+		}
+		// Values of different dynamic types are never equal, so the type is part of the hash
+		elem := v.Elem()
+		return combine(hashString(elem.Type().String()), hashValue(elem))
+	case reflect.Array:
+		h := mix(uint64(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			h = combine(h, hashValue(v.Index(i)))
+		}
+		return h
+	case reflect.Struct:
+		h := mix(uint64(v.NumField()))
+		for i := 0; i < v.NumField(); i++ {
+			h = combine(h, hashValue(v.Field(i)))
+		}
+		return h
+	}
+	// Functions, maps and slices cannot be compared with ==, so they cannot be keys
+	return hashString(v.Type().String())
+}
+
+// combine folds the hash of a part into the hash of a whole, the order of the parts matters
+func combine(h, part uint64) uint64 {
+	return mix(h*31 + part)
+}
+
+// hashString hashes a string with FNV-1a (64-bit)
+func hashString(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// hashFloat hashes a float so that 0 and -0, which compare equal, get the same hash
+func hashFloat(f float64) uint64 {
+	if f == 0 {
+		return mix(0)
+	}
+	return mix(math.Float64bits(f))
+}
+
+// mix scrambles the bits of an integer (SplitMix64 finalizer) so that
+// consecutive keys are spread across the whole trie
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
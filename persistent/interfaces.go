@@ -0,0 +1,37 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// Reference: https://en.wikipedia.org/wiki/Persistent_data_structure
+
+package persistent
+
+// PersistentMap defines the operations of an immutable map
+// Updates never modify the receiver; they return a new version instead.
+type PersistentMap[K comparable, V any] interface {
+	Get(key K) (V, bool)               // Retrieve the value associated with the key
+	Contains(key K) bool               // Check if the map contains the key
+	Put(key K, value V) *Map[K, V]     // Return a new version with the key set to value
+	Remove(key K) *Map[K, V]           // Return a new version without the key
+	Len() int                          // Return the number of key-value pairs
+	IsEmpty() bool                     // Check if the map is empty
+	Each(fn func(key K, value V) bool) // Call fn for every pair until it returns false
+	Keys() []K                         // Return all keys as a slice
+	Values() []V                       // Return all values as a slice
+	Builder() *MapBuilder[K, V]        // Return a transient builder for bulk updates
+	String() string                    // Return a string representation of the map
+}
+
+// PersistentSet defines the operations of an immutable set
+// Updates never modify the receiver; they return a new version instead.
+type PersistentSet[T comparable] interface {
+	Add(values ...T) *Set[T]    // Return a new version that also contains the values
+	Remove(values ...T) *Set[T] // Return a new version without the values
+	Contains(values ...T) bool  // Check if the set contains all the values
+	Size() int                  // Return the number of elements
+	IsEmpty() bool              // Check if the set is empty
+	Each(fn func(item T) bool)  // Call fn for every element until it returns false
+	Values() []T                // Return all elements as a slice
+	Builder() *SetBuilder[T]    // Return a transient builder for bulk updates
+	String() string             // Return a string representation of the set
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package persistent
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewMap creates a new empty persistent map using the default hash function
+func NewMap[K comparable, V any]() *Map[K, V] {
+	return NewMapWithHasher[K, V](defaultHash[K])
+}
+
+// NewMapWithHasher creates a new empty persistent map using a custom hash function
+// Keys that are equal must produce the same hash.
+func NewMapWithHasher[K comparable, V any](hasher func(K) uint64) *Map[K, V] {
+	return &Map[K, V]{root: &node[K, V]{}, hasher: hasher}
+}
+
+// Get retrieves the value associated with the key
+// Returns the value and a boolean indicating whether the key exists in the map.
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	return m.root.get(m.hasher(key), key)
+}
+
+// Contains checks if the map contains the key
+func (m *Map[K, V]) Contains(key K) bool {
+	_, exists := m.Get(key)
+	return exists
+}
+
+// Put returns a new map in which key is associated with value
+// The receiver is left unchanged and shares all untouched nodes with the result.
+func (m *Map[K, V]) Put(key K, value V) *Map[K, V] {
+	root, added := m.root.put(nil, 0, m.hasher(key), key, value)
+	result := &Map[K, V]{root: root, size: m.size, hasher: m.hasher}
+	if added {
+		result.size++
+	}
+	return result
+}
+
+// Remove returns a new map without the key
+// If the key is not present, the receiver itself is returned.
+func (m *Map[K, V]) Remove(key K) *Map[K, V] {
+	root, removed := m.root.remove(nil, 0, m.hasher(key), key)
+	if !removed {
+		return m
+	}
+	return &Map[K, V]{root: root, size: m.size - 1, hasher: m.hasher}
+}
+
+// Len returns the number of key-value pairs in the map
+func (m *Map[K, V]) Len() int {
+	return m.size
+}
+
+// IsEmpty checks if the map is empty
+func (m *Map[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Each calls fn for every key-value pair in no particular order
+// Iteration stops early as soon as fn returns false
+func (m *Map[K, V]) Each(fn func(key K, value V) bool) {
+	m.root.each(fn)
+}
+
+// Keys returns a slice containing all keys in the map
+func (m *Map[K, V]) Keys() []K {
+	keys := make([]K, 0, m.size)
+	m.Each(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values returns a slice containing all values in the map, in the same order as Keys
+func (m *Map[K, V]) Values() []V {
+	values := make([]V, 0, m.size)
+	m.Each(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Builder returns a transient builder starting from the content of the map
+// Creating the builder is O(1); the map itself is never modified.
+func (m *Map[K, V]) Builder() *MapBuilder[K, V] {
+	return &MapBuilder[K, V]{root: m.root, size: m.size, hasher: m.hasher, owner: &owner{}}
+}
+
+// String returns a string representation of the map
+func (m *Map[K, V]) String() string {
+	// Create a slice to hold string representations of the pairs
+	var elements []string
+	m.Each(func(key K, value V) bool {
+		elements = append(elements, fmt.Sprintf("%v: %v", key, value))
+		return true
+	})
+
+	// Join all the pairs with commas and wrap them in curly brackets
+	return fmt.Sprintf("Map elements: {%s}", strings.Join(elements, ", "))
+}
+
+// NewMapBuilder creates a new empty map builder using the default hash function
+func NewMapBuilder[K comparable, V any]() *MapBuilder[K, V] {
+	return NewMap[K, V]().Builder()
+}
+
+// Put associates key with value in the builder
+func (b *MapBuilder[K, V]) Put(key K, value V) {
+	root, added := b.root.put(b.owner, 0, b.hasher(key), key, value)
+	b.root = root
+	if added {
+		b.size++
+	}
+}
+
+// Remove deletes the key from the builder
+func (b *MapBuilder[K, V]) Remove(key K) {
+	root, removed := b.root.remove(b.owner, 0, b.hasher(key), key)
+	b.root = root
+	if removed {
+		b.size--
+	}
+}
+
+// Get retrieves the value associated with the key
+func (b *MapBuilder[K, V]) Get(key K) (V, bool) {
+	return b.root.get(b.hasher(key), key)
+}
+
+// Len returns the number of key-value pairs in the builder
+func (b *MapBuilder[K, V]) Len() int {
+	return b.size
+}
+
+// Map returns a persistent map with the current content of the builder
+// The builder stays usable, later edits copy the nodes shared with the returned map.
+func (b *MapBuilder[K, V]) Map() *Map[K, V] {
+	// Hand the nodes over to the map by giving the builder a fresh owner token
+	b.owner = &owner{}
+	return &Map[K, V]{root: b.root, size: b.size, hasher: b.hasher}
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package persistent
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestMap_PutGet(t *testing.T) {
+	m := NewMap[string, int]()
+	if !m.IsEmpty() || m.Len() != 0 {
+		t.Errorf("Expected new map to be empty")
+	}
+
+	m = m.Put("a", 1).Put("b", 2).Put("a", 3)
+	if m.Len() != 2 {
+		t.Errorf("Expected length 2, got %d", m.Len())
+	}
+	if value, ok := m.Get("a"); !ok || value != 3 {
+		t.Errorf("Expected a=3, got %v (%v)", value, ok)
+	}
+	if value, ok := m.Get("b"); !ok || value != 2 {
+		t.Errorf("Expected b=2, got %v (%v)", value, ok)
+	}
+	if _, ok := m.Get("c"); ok || m.Contains("c") {
+		t.Errorf("Expected c to be missing")
+	}
+}
+
+func TestMap_Immutability(t *testing.T) {
+	v1 := NewMap[int, string]().Put(1, "one")
+	v2 := v1.Put(2, "two")
+	v3 := v2.Put(1, "uno")
+	v4 := v3.Remove(2)
+
+	if v1.Len() != 1 || v1.Contains(2) {
+		t.Errorf("Expected v1 to be unchanged, got %v", v1)
+	}
+	if value, _ := v2.Get(1); value != "one" {
+		t.Errorf("Expected v2 to keep 1=one, got %v", value)
+	}
+	if value, _ := v3.Get(1); value != "uno" || !v3.Contains(2) {
+		t.Errorf("Expected v3 to have 1=uno and 2, got %v", v3)
+	}
+	if v4.Len() != 1 || v4.Contains(2) {
+		t.Errorf("Expected v4 to only hold 1, got %v", v4)
+	}
+
+	// Removing a missing key returns the receiver itself
+	if v4.Remove(42) != v4 {
+		t.Errorf("Expected Remove of a missing key to return the same map")
+	}
+}
+
+func TestMap_Remove(t *testing.T) {
+	m := NewMap[int, int]()
+	for i := 0; i < 1000; i++ {
+		m = m.Put(i, i*i)
+	}
+	for i := 0; i < 1000; i += 2 {
+		m = m.Remove(i)
+	}
+
+	if m.Len() != 500 {
+		t.Errorf("Expected length 500, got %d", m.Len())
+	}
+	for i := 0; i < 1000; i++ {
+		value, ok := m.Get(i)
+		if ok != (i%2 == 1) || (ok && value != i*i) {
+			t.Errorf("Unexpected entry for %d: %v (%v)", i, value, ok)
+		}
+	}
+}
+
+func TestMap_Collisions(t *testing.T) {
+	// A constant hash forces every key into a single collision node
+	m := NewMapWithHasher[string, int](func(string) uint64 { return 42 })
+	m = m.Put("a", 1).Put("b", 2).Put("c", 3).Put("b", 20)
+
+	if m.Len() != 3 {
+		t.Errorf("Expected length 3, got %d", m.Len())
+	}
+	if value, ok := m.Get("b"); !ok || value != 20 {
+		t.Errorf("Expected b=20, got %v (%v)", value, ok)
+	}
+
+	m = m.Remove("a").Remove("c")
+	if m.Len() != 1 || m.Contains("a") || !m.Contains("b") {
+		t.Errorf("Expected only b to remain, got %v", m)
+	}
+	m = m.Remove("b")
+	if !m.IsEmpty() || m.Contains("b") {
+		t.Errorf("Expected map to be empty, got %v", m)
+	}
+}
+
+func TestMap_KeysValuesEach(t *testing.T) {
+	m := NewMap[int, int]().Put(1, 10).Put(2, 20).Put(3, 30)
+
+	keys := m.Keys()
+	sort.Ints(keys)
+	if len(keys) != 3 || keys[0] != 1 || keys[2] != 3 {
+		t.Errorf("Expected keys [1 2 3], got %v", keys)
+	}
+
+	sum := 0
+	for _, value := range m.Values() {
+		sum += value
+	}
+	if sum != 60 {
+		t.Errorf("Expected values to sum to 60, got %d", sum)
+	}
+
+	visited := 0
+	m.Each(func(key, value int) bool {
+		if value != key*10 {
+			t.Errorf("Unexpected pair %d: %d", key, value)
+		}
+		visited++
+		return visited < 2
+	})
+	if visited != 2 {
+		t.Errorf("Expected Each to stop after 2 pairs, visited %d", visited)
+	}
+}
+
+func TestMap_DefaultHash(t *testing.T) {
+	type point struct{ X, Y int }
+
+	m := NewMap[interface{}, string]()
+	m = m.Put(1, "int").Put(int64(1), "int64").Put(1.0, "float").Put(true, "bool").Put(point{1, 2}, "point")
+	m = m.Put(0.0, "zero")
+
+	if m.Len() != 6 {
+		t.Errorf("Expected 6 distinct keys, got %d", m.Len())
+	}
+	if value, _ := m.Get(point{1, 2}); value != "point" {
+		t.Errorf("Expected struct keys to be found, got %v", value)
+	}
+	// 0 and -0 compare equal, so they must be the same key
+	if value, ok := m.Get(math.Copysign(0, -1)); !ok || value != "zero" {
+		t.Errorf("Expected -0 to find the entry for 0, got %v (%v)", value, ok)
+	}
+}
+
+func TestMap_DefaultHashPointerKey(t *testing.T) {
+	// Pointers are equal by identity, so changing the pointee must not lose the entry
+	type session struct{ user string }
+	a, b := &session{"alice"}, &session{"alice"}
+
+	m := NewMap[*session, int]().Put(a, 1)
+	a.user = "bob"
+	if value, ok := m.Get(a); !ok || value != 1 {
+		t.Errorf("Expected the mutated pointer to be found, got %v (%v)", value, ok)
+	}
+	if _, ok := m.Get(b); ok {
+		t.Errorf("Expected another pointer to the same content to be a different key")
+	}
+
+	ch := make(chan int)
+	keys := NewMap[interface{}, string]().Put(ch, "chan").Put(a, "pointer")
+	if value, _ := keys.Get(ch); value != "chan" {
+		t.Errorf("Expected channel keys to be found, got %v", value)
+	}
+	if value, _ := keys.Get(a); value != "pointer" {
+		t.Errorf("Expected pointer keys behind an interface to be found, got %v", value)
+	}
+}
+
+func TestMap_DefaultHashNegativeZero(t *testing.T) {
+	// Structs and arrays holding 0 and -0 compare equal, so they must be the same key
+	type point struct {
+		X, Y float64
+		Tag  interface{}
+	}
+	negativeZero := math.Copysign(0, -1)
+
+	m := NewMap[point, string]().Put(point{X: 0, Y: 1, Tag: 0.0}, "point")
+	if value, ok := m.Get(point{X: negativeZero, Y: 1, Tag: negativeZero}); !ok || value != "point" {
+		t.Errorf("Expected -0 fields to find the entry, got %v (%v)", value, ok)
+	}
+
+	arrays := NewMap[[2]float64, string]().Put([2]float64{0, 1}, "array")
+	if value, ok := arrays.Get([2]float64{negativeZero, 1}); !ok || value != "array" {
+		t.Errorf("Expected -0 elements to find the entry, got %v (%v)", value, ok)
+	}
+	if m.Put(point{X: negativeZero, Y: 1, Tag: 0.0}, "again").Len() != 1 {
+		t.Errorf("Expected -0 to replace the entry for 0")
+	}
+}
+
+func TestMapBuilder(t *testing.T) {
+	base := NewMap[int, int]().Put(1, 1)
+
+	b := base.Builder()
+	for i := 2; i <= 100; i++ {
+		b.Put(i, i)
+	}
+	b.Remove(1)
+	if b.Len() != 99 {
+		t.Errorf("Expected builder length 99, got %d", b.Len())
+	}
+	if value, ok := b.Get(50); !ok || value != 50 {
+		t.Errorf("Expected builder to hold 50, got %v (%v)", value, ok)
+	}
+
+	built := b.Map()
+	if built.Len() != 99 || built.Contains(1) {
+		t.Errorf("Expected built map with 99 keys and no 1, got %d keys", built.Len())
+	}
+	if base.Len() != 1 || !base.Contains(1) {
+		t.Errorf("Expected base map to be unchanged, got %v", base)
+	}
+
+	// Edits after Map() must not leak into the returned map
+	b.Put(1000, 1000)
+	b.Remove(2)
+	if built.Contains(1000) || !built.Contains(2) {
+		t.Errorf("Expected built map to be unaffected by later builder edits")
+	}
+
+	empty := NewMapBuilder[string, int]()
+	empty.Put("x", 1)
+	if m := empty.Map(); m.Len() != 1 {
+		t.Errorf("Expected length 1, got %d", m.Len())
+	}
+}
+
+func TestMap_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	reference := make(map[int]int)
+	m := NewMap[int, int]()
+	versions := []*Map[int, int]{m}
+	sizes := []int{0}
+
+	for i := 0; i < 5000; i++ {
+		key := r.Intn(500)
+		if r.Intn(3) == 0 {
+			delete(reference, key)
+			m = m.Remove(key)
+		} else {
+			reference[key] = i
+			m = m.Put(key, i)
+		}
+		if i%500 == 0 {
+			versions = append(versions, m)
+			sizes = append(sizes, len(reference))
+		}
+	}
+
+	if m.Len() != len(reference) {
+		t.Fatalf("Expected length %d, got %d", len(reference), m.Len())
+	}
+	for key, expected := range reference {
+		if value, ok := m.Get(key); !ok || value != expected {
+			t.Errorf("Expected %d=%d, got %v (%v)", key, expected, value, ok)
+		}
+	}
+	// Older versions keep their own sizes
+	for i, version := range versions {
+		if version.Len() != sizes[i] || len(version.Keys()) != sizes[i] {
+			t.Errorf("Expected version %d to hold %d keys, got %d", i, sizes[i], version.Len())
+		}
+	}
+}
+
+func TestMap_String(t *testing.T) {
+	m := NewMap[string, int]().Put("a", 1)
+	expected := "Map elements: {a: 1}"
+	if m.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, m.String())
+	}
+}
+
+func TestMapBuilder_RandomWithCollisions(t *testing.T) {
+	// A weak hash puts many keys into the same collision nodes
+	r := rand.New(rand.NewSource(2))
+	reference := make(map[int]int)
+	b := NewMapWithHasher[int, int](func(k int) uint64 { return uint64(k % 16) }).Builder()
+
+	for i := 0; i < 3000; i++ {
+		key := r.Intn(200)
+		if r.Intn(3) == 0 {
+			delete(reference, key)
+			b.Remove(key)
+		} else {
+			reference[key] = i
+			b.Put(key, i)
+		}
+	}
+
+	m := b.Map()
+	if m.Len() != len(reference) || len(m.Keys()) != len(reference) {
+		t.Fatalf("Expected length %d, got %d", len(reference), m.Len())
+	}
+	for key, expected := range reference {
+		if value, ok := m.Get(key); !ok || value != expected {
+			t.Errorf("Expected %d=%d, got %v (%v)", key, expected, value, ok)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// Reference: https://en.wikipedia.org/wiki/Hash_array_mapped_trie
+
+package persistent
+
+import (
+	"math/bits"
+)
+
+// get looks up key in the trie rooted at n
+func (n *node[K, V]) get(hash uint64, key K) (V, bool) {
+	for shift := uint(0); ; shift += bitsPerLevel {
+		// Below the last level, scan the colliding entries
+		if shift > maxShift {
+			for _, e := range n.entries {
+				if e.key == key {
+					return e.value, true
+				}
+			}
+			break
+		}
+
+		bit := bitFor(hash, shift)
+		if n.bitmap&bit == 0 {
+			break
+		}
+		e := &n.entries[n.indexOf(bit)]
+		if e.sub == nil {
+			if e.hash == hash && e.key == key {
+				return e.value, true
+			}
+			break
+		}
+		// Descend into the child node
+		n = e.sub
+	}
+
+	var zeroValue V
+	return zeroValue, false
+}
+
+// put inserts or updates key in the trie rooted at n
+// It returns the new root of the subtree and whether a new key was added.
+// Nodes owned by o are edited in place, all others are copied.
+func (n *node[K, V]) put(o *owner, shift uint, hash uint64, key K, value V) (*node[K, V], bool) {
+	leaf := entry[K, V]{hash: hash, key: key, value: value}
+
+	// Below the last level, the node is a plain list of colliding entries
+	if shift > maxShift {
+		for i := range n.entries {
+			if n.entries[i].key == key {
+				edited := n.editable(o)
+				edited.entries[i].value = value
+				return edited, false
+			}
+		}
+		edited := n.editable(o)
+		edited.entries = append(edited.entries, leaf)
+		return edited, true
+	}
+
+	bit := bitFor(hash, shift)
+	i := n.indexOf(bit)
+
+	// Empty slot: insert the new pair at its position
+	if n.bitmap&bit == 0 {
+		edited := n.editable(o)
+		edited.bitmap |= bit
+		edited.entries = append(edited.entries, entry[K, V]{})
+		copy(edited.entries[i+1:], edited.entries[i:])
+		edited.entries[i] = leaf
+		return edited, true
+	}
+
+	e := n.entries[i]
+	switch {
+	case e.sub != nil:
+		// Child node: insert recursively and relink the possibly copied child
+		sub, added := e.sub.put(o, shift+bitsPerLevel, hash, key, value)
+		edited := n.editable(o)
+		edited.entries[i].sub = sub
+		return edited, added
+	case e.hash == hash && e.key == key:
+		// Same key: replace the value
+		edited := n.editable(o)
+		edited.entries[i].value = value
+		return edited, false
+	default:
+		// Different key in the slot: push both pairs down into a new child node
+		edited := n.editable(o)
+		edited.entries[i] = entry[K, V]{sub: newBranch(o, shift+bitsPerLevel, e, leaf)}
+		return edited, true
+	}
+}
+
+// remove deletes key from the trie rooted at n
+// It returns the new root of the subtree and whether the key was found.
+// Nodes owned by o are edited in place, all others are copied.
+func (n *node[K, V]) remove(o *owner, shift uint, hash uint64, key K) (*node[K, V], bool) {
+	// Below the last level, the node is a plain list of colliding entries
+	if shift > maxShift {
+		for i := range n.entries {
+			if n.entries[i].key == key {
+				edited := n.editable(o)
+				edited.entries = append(edited.entries[:i], edited.entries[i+1:]...)
+				return edited, true
+			}
+		}
+		return n, false
+	}
+
+	bit := bitFor(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	i := n.indexOf(bit)
+	e := n.entries[i]
+
+	if e.sub == nil {
+		if e.hash != hash || e.key != key {
+			return n, false
+		}
+		// Matching pair: drop the slot
+		edited := n.editable(o)
+		edited.bitmap &^= bit
+		edited.entries = append(edited.entries[:i], edited.entries[i+1:]...)
+		return edited, true
+	}
+
+	sub, removed := e.sub.remove(o, shift+bitsPerLevel, hash, key)
+	if !removed {
+		return n, false
+	}
+
+	edited := n.editable(o)
+	switch {
+	case len(sub.entries) == 0:
+		// The child became empty: drop the slot
+		edited.bitmap &^= bit
+		edited.entries = append(edited.entries[:i], edited.entries[i+1:]...)
+	case len(sub.entries) == 1 && sub.entries[0].sub == nil:
+		// The child holds a single pair: pull it up to keep the trie compact
+		edited.entries[i] = sub.entries[0]
+	default:
+		edited.entries[i].sub = sub
+	}
+	return edited, true
+}
+
+// each calls fn for every pair in the trie rooted at n until fn returns false
+// It returns false if the iteration was stopped early.
+func (n *node[K, V]) each(fn func(key K, value V) bool) bool {
+	for i := range n.entries {
+		e := &n.entries[i]
+		if e.sub != nil {
+			if !e.sub.each(fn) {
+				return false
+			}
+		} else if !fn(e.key, e.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// editable returns n itself if it is owned by o, or a copy owned by o otherwise
+func (n *node[K, V]) editable(o *owner) *node[K, V] {
+	if o != nil && n.owner == o {
+		return n
+	}
+	// Leave room for one more entry since most edits insert
+	entries := make([]entry[K, V], len(n.entries), len(n.entries)+1)
+	copy(entries, n.entries)
+	return &node[K, V]{bitmap: n.bitmap, entries: entries, owner: o}
+}
+
+// indexOf returns the position in entries of the slot selected by bit
+func (n *node[K, V]) indexOf(bit uint32) int {
+	return bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+// newBranch creates the smallest subtree at shift holding two pairs with different keys
+func newBranch[K comparable, V any](o *owner, shift uint, a, b entry[K, V]) *node[K, V] {
+	// The hashes are exhausted: store both pairs in a collision node
+	if shift > maxShift {
+		return &node[K, V]{entries: []entry[K, V]{a, b}, owner: o}
+	}
+
+	bitA, bitB := bitFor(a.hash, shift), bitFor(b.hash, shift)
+	if bitA == bitB {
+		// Both pairs share the slot at this level as well, go one level deeper
+		child := newBranch(o, shift+bitsPerLevel, a, b)
+		return &node[K, V]{bitmap: bitA, entries: []entry[K, V]{{sub: child}}, owner: o}
+	}
+	if bitA > bitB {
+		a, b = b, a
+	}
+	return &node[K, V]{bitmap: bitA | bitB, entries: []entry[K, V]{a, b}, owner: o}
+}
+
+// bitFor returns the bitmap bit selected by the hash bits at shift
+func bitFor(hash uint64, shift uint) uint32 {
+	return 1 << ((hash >> shift) & levelMask)
+}
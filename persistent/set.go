@@ -0,0 +1,134 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package persistent
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewSet creates a new persistent set holding the given values
+func NewSet[T comparable](values ...T) *Set[T] {
+	return NewSetWithHasher(defaultHash[T], values...)
+}
+
+// NewSetWithHasher creates a new persistent set using a custom hash function
+// Values that are equal must produce the same hash.
+func NewSetWithHasher[T comparable](hasher func(T) uint64, values ...T) *Set[T] {
+	return (&Set[T]{m: NewMapWithHasher[T, struct{}](hasher)}).Add(values...)
+}
+
+// Add returns a new set that also contains the given values
+// The receiver is left unchanged and shares all untouched nodes with the result.
+func (s *Set[T]) Add(values ...T) *Set[T] {
+	if len(values) == 0 {
+		return s
+	}
+	// Use a builder so that nodes created for earlier values are not copied again
+	b := s.Builder()
+	b.Add(values...)
+	return b.Set()
+}
+
+// Remove returns a new set without the given values
+func (s *Set[T]) Remove(values ...T) *Set[T] {
+	if len(values) == 0 {
+		return s
+	}
+	b := s.Builder()
+	b.Remove(values...)
+	if b.Size() == s.Size() {
+		// Nothing was removed, keep sharing the receiver
+		return s
+	}
+	return b.Set()
+}
+
+// Contains checks if all the given values are present in the set
+func (s *Set[T]) Contains(values ...T) bool {
+	for _, value := range values {
+		if !s.m.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Size returns the number of elements in the set
+func (s *Set[T]) Size() int {
+	return s.m.Len()
+}
+
+// IsEmpty checks if the set is empty
+func (s *Set[T]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+// Each calls fn for every element in no particular order
+// Iteration stops early as soon as fn returns false
+func (s *Set[T]) Each(fn func(item T) bool) {
+	s.m.Each(func(key T, _ struct{}) bool {
+		return fn(key)
+	})
+}
+
+// Values returns a slice containing all elements in the set
+func (s *Set[T]) Values() []T {
+	return s.m.Keys()
+}
+
+// Builder returns a transient builder starting from the content of the set
+func (s *Set[T]) Builder() *SetBuilder[T] {
+	return &SetBuilder[T]{b: s.m.Builder()}
+}
+
+// String returns a string representation of the set
+func (s *Set[T]) String() string {
+	// Create a slice to hold string representations of the elements
+	var elements []string
+	s.Each(func(item T) bool {
+		elements = append(elements, fmt.Sprintf("%v", item))
+		return true
+	})
+
+	// Join all the elements with commas and wrap them in square brackets
+	return fmt.Sprintf("Set elements: [%s]", strings.Join(elements, ", "))
+}
+
+// NewSetBuilder creates a new empty set builder using the default hash function
+func NewSetBuilder[T comparable]() *SetBuilder[T] {
+	return NewSet[T]().Builder()
+}
+
+// Add adds one or more values to the builder
+func (b *SetBuilder[T]) Add(values ...T) {
+	for _, value := range values {
+		b.b.Put(value, struct{}{})
+	}
+}
+
+// Remove removes one or more values from the builder
+func (b *SetBuilder[T]) Remove(values ...T) {
+	for _, value := range values {
+		b.b.Remove(value)
+	}
+}
+
+// Contains checks if the value is present in the builder
+func (b *SetBuilder[T]) Contains(value T) bool {
+	_, exists := b.b.Get(value)
+	return exists
+}
+
+// Size returns the number of elements in the builder
+func (b *SetBuilder[T]) Size() int {
+	return b.b.Len()
+}
+
+// Set returns a persistent set with the current content of the builder
+// The builder stays usable, later edits copy the nodes shared with the returned set.
+func (b *SetBuilder[T]) Set() *Set[T] {
+	return &Set[T]{m: b.b.Map()}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package persistent
+
+import (
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestSet_AddRemove(t *testing.T) {
+	s1 := NewSet(1, 2, 3)
+	s2 := s1.Add(4, 5)
+	s3 := s2.Remove(1, 2)
+
+	if s1.Size() != 3 || s1.Contains(4) {
+		t.Errorf("Expected s1 to be unchanged, got %v", s1)
+	}
+	if s2.Size() != 5 || !s2.Contains(1, 2, 3, 4, 5) {
+		t.Errorf("Expected s2 to hold 1..5, got %v", s2)
+	}
+	if s3.Size() != 3 || s3.Contains(1) || !s3.Contains(3, 4, 5) {
+		t.Errorf("Expected s3 to hold 3..5, got %v", s3)
+	}
+
+	// Operations that change nothing share the receiver
+	if s3.Remove(42) != s3 || s3.Add() != s3 {
+		t.Errorf("Expected no-op updates to return the same set")
+	}
+}
+
+func TestSet_Values(t *testing.T) {
+	s := NewSet("b", "a", "c", "a")
+	values := s.Values()
+	sort.Strings(values)
+	if len(values) != 3 || values[0] != "a" || values[2] != "c" {
+		t.Errorf("Expected [a b c], got %v", values)
+	}
+
+	visited := 0
+	s.Each(func(string) bool {
+		visited++
+		return false
+	})
+	if visited != 1 {
+		t.Errorf("Expected Each to stop after 1 element, visited %d", visited)
+	}
+
+	if !NewSet[int]().IsEmpty() {
+		t.Errorf("Expected new set to be empty")
+	}
+}
+
+func TestSetBuilder(t *testing.T) {
+	b := NewSetBuilder[int]()
+	for i := 0; i < 100; i++ {
+		b.Add(i)
+	}
+	b.Remove(0)
+	if b.Size() != 99 || b.Contains(0) || !b.Contains(99) {
+		t.Errorf("Unexpected builder content, size %d", b.Size())
+	}
+
+	s := b.Set()
+	b.Add(1000)
+	if s.Size() != 99 || s.Contains(1000) {
+		t.Errorf("Expected the built set to be unaffected by later builder edits")
+	}
+}
+
+func TestSet_ConcurrentReads(t *testing.T) {
+	snapshot := NewSet[int]()
+	for i := 0; i < 1000; i++ {
+		snapshot = snapshot.Add(i)
+	}
+
+	// Snapshots are immutable, so readers and writers of new versions need no locking
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			local := snapshot
+			for i := 0; i < 1000; i++ {
+				if !snapshot.Contains(i) {
+					t.Errorf("Expected snapshot to contain %d", i)
+				}
+				local = local.Add(1000 + g*1000 + i)
+			}
+			if local.Size() != 2000 {
+				t.Errorf("Expected local version to hold 2000 elements, got %d", local.Size())
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if snapshot.Size() != 1000 {
+		t.Errorf("Expected snapshot to keep 1000 elements, got %d", snapshot.Size())
+	}
+}
+
+func TestSet_String(t *testing.T) {
+	s := NewSet("a")
+	expected := "Set elements: [a]"
+	if s.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, s.String())
+	}
+}
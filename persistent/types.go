@@ -0,0 +1,54 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package persistent
+
+// Map represents an immutable hash map implemented as a hash array mapped trie (HAMT)
+// Every update returns a new Map that shares all untouched nodes with the old one.
+type Map[K comparable, V any] struct {
+	root   *node[K, V]    // Root node of the trie
+	size   int            // Number of key-value pairs in the map
+	hasher func(K) uint64 // Hash function used to place keys in the trie
+}
+
+// MapBuilder is a transient, mutable view of a Map used for bulk construction
+// It edits the nodes it created in place instead of copying them on every update.
+type MapBuilder[K comparable, V any] struct {
+	root   *node[K, V]    // Root node of the trie
+	size   int            // Number of key-value pairs in the builder
+	hasher func(K) uint64 // Hash function used to place keys in the trie
+	owner  *owner         // Token marking the nodes this builder may edit in place
+}
+
+// Set represents an immutable hash set backed by a Map
+type Set[T comparable] struct {
+	m *Map[T, struct{}] // Underlying map, the values are unused
+}
+
+// SetBuilder is a transient, mutable view of a Set used for bulk construction
+type SetBuilder[T comparable] struct {
+	b *MapBuilder[T, struct{}] // Underlying map builder, the values are unused
+}
+
+// node represents a node of the trie
+// Regular nodes store up to 32 entries indexed by a bitmap of the hash bits at their level.
+// Below the last level, nodes hold a plain list of entries whose hashes collide completely.
+type node[K comparable, V any] struct {
+	bitmap  uint32        // Bit i is set if the entry for hash bits i is present
+	entries []entry[K, V] // Present entries in bitmap order
+	owner   *owner        // Builder allowed to edit this node in place, nil if frozen
+}
+
+// entry represents either a key-value pair (sub is nil) or a pointer to a child node
+type entry[K comparable, V any] struct {
+	hash  uint64      // Hash of the key
+	key   K           // Key of the pair
+	value V           // Value of the pair
+	sub   *node[K, V] // Child node, nil for key-value pairs
+}
+
+// owner is an identity token shared by a builder and the nodes it created
+type owner struct {
+	_ int // Non-zero size so that every token has a distinct address
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package array
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON encodes the queue as a JSON array ordered from front to back
+// An empty queue encodes as [] rather than null.
+func (q *Queues) MarshalJSON() ([]byte, error) {
+	return json.Marshal(append([]interface{}{}, q.items...))
+}
+
+// UnmarshalJSON decodes a JSON array, replacing the content of the queue
+// Elements are decoded with the default encoding/json types, so numbers become float64.
+func (q *Queues) UnmarshalJSON(data []byte) error {
+	var values []interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	q.items = values
+	return nil
+}
+
+// MarshalText encodes the queue as its JSON representation
+func (q *Queues) MarshalText() ([]byte, error) {
+	return q.MarshalJSON()
+}
+
+// UnmarshalText decodes text produced by MarshalText, replacing the content of the queue
+func (q *Queues) UnmarshalText(text []byte) error {
+	return q.UnmarshalJSON(text)
+}
+
+// GobEncode encodes the elements of the queue with encoding/gob
+// Concrete element types other than the gob built-ins must be registered with gob.Register.
+func (q *Queues) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(q.items); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes data produced by GobEncode, replacing the content of the queue
+func (q *Queues) GobDecode(data []byte) error {
+	var values []interface{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	q.items = values
+	return nil
+}
+
+// MarshalJSON encodes the queue as a JSON array ordered from front to back
+// An empty queue encodes as [] rather than null.
+func (q *GenericQueue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(append([]T{}, q.items...))
+}
+
+// UnmarshalJSON decodes a JSON array, replacing the content of the queue
+func (q *GenericQueue[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	q.items = values
+	return nil
+}
+
+// MarshalText encodes the queue as its JSON representation
+func (q *GenericQueue[T]) MarshalText() ([]byte, error) {
+	return q.MarshalJSON()
+}
+
+// UnmarshalText decodes text produced by MarshalText, replacing the content of the queue
+func (q *GenericQueue[T]) UnmarshalText(text []byte) error {
+	return q.UnmarshalJSON(text)
+}
+
+// GobEncode encodes the elements of the queue with encoding/gob
+func (q *GenericQueue[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(q.items); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes data produced by GobEncode, replacing the content of the queue
+func (q *GenericQueue[T]) GobDecode(data []byte) error {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	q.items = values
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package array
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestQueue_MarshalJSON(t *testing.T) {
+	q := New()
+	q.Enqueue("a", "b", "c")
+
+	data, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != `["a","b","c"]` {
+		t.Errorf(`Expected ["a","b","c"], got %s`, data)
+	}
+
+	// Decoding replaces the content of the queue
+	decoded := New()
+	decoded.Enqueue("stale")
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Dequeue() != "a" || decoded.Size() != 2 {
+		t.Errorf("Expected queue order to survive the round trip and stale elements to be dropped, got %v", decoded)
+	}
+
+	if data, _ := json.Marshal(New()); string(data) != "[]" {
+		t.Errorf("Expected empty queue to encode as [], got %s", data)
+	}
+}
+
+func TestQueue_MarshalText(t *testing.T) {
+	q := New()
+	q.Enqueue("x", "y")
+	text, err := q.MarshalText()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	decoded := New()
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.Values(), q.Values()) {
+		t.Errorf("Expected %v after round trip, got %v", q, decoded)
+	}
+}
+
+func TestQueue_GobEncode(t *testing.T) {
+	q := New()
+	q.Enqueue(1, "two", 3.0)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(q); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := New()
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.Values(), q.Values()) {
+		t.Errorf("Expected %v after round trip, got %v", q, decoded)
+	}
+}
+
+func TestGenericQueue_Marshal(t *testing.T) {
+	q := NewGenericQueue[int]()
+	q.Enqueue(1, 2, 3)
+
+	data, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromJSON := NewGenericQueue[int]()
+	if err := json.Unmarshal(data, fromJSON); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fromJSON.Values(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] after JSON round trip, got %v", fromJSON.Values())
+	}
+
+	text, err := q.MarshalText()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromText := NewGenericQueue[int]()
+	if err := fromText.UnmarshalText(text); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fromText.Values(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] after text round trip, got %v", fromText.Values())
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(q); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromGob := NewGenericQueue[int]()
+	if err := gob.NewDecoder(&buf).Decode(fromGob); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fromGob.Values(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] after gob round trip, got %v", fromGob.Values())
+	}
+
+	if data, _ := json.Marshal(NewGenericQueue[int]()); string(data) != "[]" {
+		t.Errorf("Expected empty queue to encode as [], got %s", data)
+	}
+}
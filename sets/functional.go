@@ -0,0 +1,171 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package sets
+
+// Each calls fn for every element of the set in no particular order
+// Iteration stops early as soon as fn returns false
+func (s *Sets) Each(fn func(item interface{}) bool) {
+	for item := range s.items {
+		if !fn(item) {
+			return
+		}
+	}
+}
+
+// Filter returns a new set containing the elements for which pred returns true
+func (s *Sets) Filter(pred func(item interface{}) bool) *Sets {
+	result := New()
+	for item := range s.items {
+		if pred(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Map returns a new set containing fn applied to every element
+// Elements mapped to the same value are merged, so the result may be smaller than the set
+func (s *Sets) Map(fn func(item interface{}) interface{}) *Sets {
+	result := New()
+	for item := range s.items {
+		result.items[fn(item)] = struct{}{}
+	}
+	return result
+}
+
+// Partition splits the set into the elements for which pred returns true
+// and the elements for which it returns false
+func (s *Sets) Partition(pred func(item interface{}) bool) (*Sets, *Sets) {
+	matched, rest := New(), New()
+	for item := range s.items {
+		if pred(item) {
+			matched.items[item] = struct{}{}
+		} else {
+			rest.items[item] = struct{}{}
+		}
+	}
+	return matched, rest
+}
+
+// Any checks if pred returns true for at least one element
+// It stops at the first match and returns false for an empty set
+func (s *Sets) Any(pred func(item interface{}) bool) bool {
+	for item := range s.items {
+		if pred(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// All checks if pred returns true for every element
+// It stops at the first mismatch and returns true for an empty set
+func (s *Sets) All(pred func(item interface{}) bool) bool {
+	for item := range s.items {
+		if !pred(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reduce folds the elements of the set into a single value, starting from initial
+// Since sets are unordered, fn should be commutative and associative
+func (s *Sets) Reduce(initial interface{}, fn func(acc, item interface{}) interface{}) interface{} {
+	acc := initial
+	for item := range s.items {
+		acc = fn(acc, item)
+	}
+	return acc
+}
+
+// Each calls fn for every element of the set in no particular order
+// Iteration stops early as soon as fn returns false
+func (s *GenericSets[T]) Each(fn func(item T) bool) {
+	for item := range s.items {
+		if !fn(item) {
+			return
+		}
+	}
+}
+
+// Filter returns a new set containing the elements for which pred returns true
+func (s *GenericSets[T]) Filter(pred func(item T) bool) *GenericSets[T] {
+	result := NewGenericSet[T]()
+	for item := range s.items {
+		if pred(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Map returns a new set containing fn applied to every element
+// Use MapTo to map the elements to a different type
+func (s *GenericSets[T]) Map(fn func(item T) T) *GenericSets[T] {
+	return MapTo(s, fn)
+}
+
+// Partition splits the set into the elements for which pred returns true
+// and the elements for which it returns false
+func (s *GenericSets[T]) Partition(pred func(item T) bool) (*GenericSets[T], *GenericSets[T]) {
+	matched, rest := NewGenericSet[T](), NewGenericSet[T]()
+	for item := range s.items {
+		if pred(item) {
+			matched.items[item] = struct{}{}
+		} else {
+			rest.items[item] = struct{}{}
+		}
+	}
+	return matched, rest
+}
+
+// Any checks if pred returns true for at least one element
+// It stops at the first match and returns false for an empty set
+func (s *GenericSets[T]) Any(pred func(item T) bool) bool {
+	for item := range s.items {
+		if pred(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// All checks if pred returns true for every element
+// It stops at the first mismatch and returns true for an empty set
+func (s *GenericSets[T]) All(pred func(item T) bool) bool {
+	for item := range s.items {
+		if !pred(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reduce folds the elements of the set into a single value of the element type, starting from initial
+// Use Fold to accumulate into a different type
+func (s *GenericSets[T]) Reduce(initial T, fn func(acc, item T) T) T {
+	return Fold(s, initial, fn)
+}
+
+// MapTo returns a new set containing fn applied to every element of s
+// Go methods cannot declare type parameters, so the type-changing map is a function
+func MapTo[T, U comparable](s *GenericSets[T], fn func(item T) U) *GenericSets[U] {
+	result := &GenericSets[U]{items: make(map[U]struct{}, len(s.items))}
+	for item := range s.items {
+		result.items[fn(item)] = struct{}{}
+	}
+	return result
+}
+
+// Fold folds the elements of s into a single value of any type, starting from initial
+// Since sets are unordered, fn should not depend on the visiting order
+func Fold[T comparable, A any](s *GenericSets[T], initial A, fn func(acc A, item T) A) A {
+	acc := initial
+	for item := range s.items {
+		acc = fn(acc, item)
+	}
+	return acc
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package sets
+
+import (
+	"strconv"
+	"testing"
+)
+
+func isEven(item interface{}) bool {
+	return item.(int)%2 == 0
+}
+
+func TestSets_Each(t *testing.T) {
+	s := New(1, 2, 3, 4)
+
+	sum := 0
+	s.Each(func(item interface{}) bool {
+		sum += item.(int)
+		return true
+	})
+	if sum != 10 {
+		t.Errorf("Expected sum 10, got %d", sum)
+	}
+
+	// Returning false stops the iteration
+	visited := 0
+	s.Each(func(item interface{}) bool {
+		visited++
+		return false
+	})
+	if visited != 1 {
+		t.Errorf("Expected Each to stop after 1 element, visited %d", visited)
+	}
+}
+
+func TestSets_Filter(t *testing.T) {
+	s := New(1, 2, 3, 4)
+	evens := s.Filter(isEven)
+	if evens.Size() != 2 || !evens.Contains(2, 4) {
+		t.Errorf("Expected {2, 4}, got %v", evens)
+	}
+	if s.Size() != 4 {
+		t.Errorf("Expected Filter not to modify the set, got size %d", s.Size())
+	}
+}
+
+func TestSets_Map(t *testing.T) {
+	s := New(1, 2, 3, 4)
+	halves := s.Map(func(item interface{}) interface{} {
+		return item.(int) / 2
+	})
+	// 1/2 and 0 collide, as do 2/2 and 3/2
+	if halves.Size() != 3 || !halves.Contains(0, 1, 2) {
+		t.Errorf("Expected {0, 1, 2}, got %v", halves)
+	}
+}
+
+func TestSets_Partition(t *testing.T) {
+	evens, odds := New(1, 2, 3, 4, 5).Partition(isEven)
+	if evens.Size() != 2 || !evens.Contains(2, 4) {
+		t.Errorf("Expected evens {2, 4}, got %v", evens)
+	}
+	if odds.Size() != 3 || !odds.Contains(1, 3, 5) {
+		t.Errorf("Expected odds {1, 3, 5}, got %v", odds)
+	}
+}
+
+func TestSets_AnyAll(t *testing.T) {
+	s := New(1, 2, 3)
+	if !s.Any(isEven) {
+		t.Errorf("Expected Any to find an even element")
+	}
+	if s.All(isEven) {
+		t.Errorf("Expected All to fail on odd elements")
+	}
+	if !New(2, 4).All(isEven) {
+		t.Errorf("Expected All to succeed on {2, 4}")
+	}
+
+	empty := New()
+	if empty.Any(isEven) {
+		t.Errorf("Expected Any to be false on an empty set")
+	}
+	if !empty.All(isEven) {
+		t.Errorf("Expected All to be true on an empty set")
+	}
+}
+
+func TestSets_Reduce(t *testing.T) {
+	sum := New(1, 2, 3, 4).Reduce(0, func(acc, item interface{}) interface{} {
+		return acc.(int) + item.(int)
+	})
+	if sum != 10 {
+		t.Errorf("Expected sum 10, got %v", sum)
+	}
+}
+
+func TestGenericSets_Functional(t *testing.T) {
+	s := NewGenericSet(1, 2, 3, 4, 5)
+	even := func(item int) bool { return item%2 == 0 }
+
+	if got := s.Filter(even); got.Size() != 2 || !got.Contains(2, 4) {
+		t.Errorf("Filter: expected {2, 4}, got %v", got)
+	}
+	if got := s.Map(func(item int) int { return item * 10 }); got.Size() != 5 || !got.Contains(10, 50) {
+		t.Errorf("Map: expected {10, 20, 30, 40, 50}, got %v", got)
+	}
+
+	evens, odds := s.Partition(even)
+	if evens.Size() != 2 || odds.Size() != 3 {
+		t.Errorf("Partition: expected sizes 2 and 3, got %d and %d", evens.Size(), odds.Size())
+	}
+
+	if !s.Any(even) || s.All(even) {
+		t.Errorf("Expected Any to be true and All to be false")
+	}
+
+	visited := 0
+	s.Each(func(item int) bool {
+		visited++
+		return visited < 2
+	})
+	if visited != 2 {
+		t.Errorf("Expected Each to stop after 2 elements, visited %d", visited)
+	}
+
+	if sum := s.Reduce(0, func(acc, item int) int { return acc + item }); sum != 15 {
+		t.Errorf("Reduce: expected 15, got %d", sum)
+	}
+}
+
+func TestMapTo(t *testing.T) {
+	s := NewGenericSet(1, 2, 3)
+	strs := MapTo(s, strconv.Itoa)
+	if strs.Size() != 3 || !strs.Contains("1", "2", "3") {
+		t.Errorf("Expected {\"1\", \"2\", \"3\"}, got %v", strs)
+	}
+}
+
+func TestFold(t *testing.T) {
+	s := NewGenericSet("a", "bb", "ccc")
+	total := Fold(s, 0, func(acc int, item string) int {
+		return acc + len(item)
+	})
+	if total != 6 {
+		t.Errorf("Expected total length 6, got %d", total)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package sets
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewGenericSet creates a new instance of a generic set
+func NewGenericSet[T comparable](values ...T) *GenericSets[T] {
+	result := &GenericSets[T]{items: make(map[T]struct{}, len(values))}
+	result.Add(values...)
+	return result
+}
+
+// Add adds one or more elements to the set
+func (s *GenericSets[T]) Add(values ...T) {
+	for _, value := range values {
+		s.items[value] = struct{}{}
+	}
+}
+
+// AddAll adds a batch of elements to the set
+func (s *GenericSets[T]) AddAll(values []T) {
+	s.Add(values...)
+}
+
+// Remove removes one or more elements from the set
+func (s *GenericSets[T]) Remove(values ...T) {
+	for _, value := range values {
+		delete(s.items, value)
+	}
+}
+
+// RemoveAll removes a batch of elements from the set
+func (s *GenericSets[T]) RemoveAll(values []T) {
+	s.Remove(values...)
+}
+
+// Contains checks if all the given elements are present in the set
+func (s *GenericSets[T]) Contains(values ...T) bool {
+	for _, value := range values {
+		if _, exists := s.items[value]; !exists {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAll checks if the set contains all the elements in the provided slice
+func (s *GenericSets[T]) ContainsAll(values []T) bool {
+	return s.Contains(values...)
+}
+
+// Size returns the number of elements in the set
+func (s *GenericSets[T]) Size() int {
+	return len(s.items)
+}
+
+// IsEmpty checks if the set is empty
+func (s *GenericSets[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// Clear removes all elements from the set
+func (s *GenericSets[T]) Clear() {
+	s.items = make(map[T]struct{})
+}
+
+// Values returns a slice containing all elements in the set
+func (s *GenericSets[T]) Values() []T {
+	values := make([]T, 0, s.Size())
+	for key := range s.items {
+		values = append(values, key)
+	}
+	return values
+}
+
+// Intersection returns a new set that contains the elements
+// that are present in both sets
+func (s *GenericSets[T]) Intersection(other *GenericSets[T]) *GenericSets[T] {
+	result := NewGenericSet[T]()
+	for item := range s.items {
+		if other.Contains(item) {
+			result.Add(item)
+		}
+	}
+	return result
+}
+
+// Union returns a new set that contains all the elements
+// from both sets (union of the sets)
+func (s *GenericSets[T]) Union(other *GenericSets[T]) *GenericSets[T] {
+	result := NewGenericSet(s.Values()...)
+	for item := range other.items {
+		result.Add(item)
+	}
+	return result
+}
+
+// Difference returns a new set that contains elements that are in the current set
+// but not in the other set (the difference of the sets)
+func (s *GenericSets[T]) Difference(other *GenericSets[T]) *GenericSets[T] {
+	result := NewGenericSet[T]()
+	for item := range s.items {
+		if !other.Contains(item) {
+			result.Add(item)
+		}
+	}
+	return result
+}
+
+// Subset checks if the current set is a subset of the other set
+func (s *GenericSets[T]) Subset(other *GenericSets[T]) bool {
+	for item := range s.items {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns a string representation of the set
+func (s *GenericSets[T]) String() string {
+	// Create a slice to hold string representations of the elements
+	var elements []string
+
+	// Iterate over the set and convert each element to a string
+	for item := range s.items {
+		elements = append(elements, fmt.Sprintf("%v", item))
+	}
+
+	// Join all the elements with commas and wrap them in square brackets
+	return fmt.Sprintf("Sets elements: [%s]", strings.Join(elements, ", "))
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package sets
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestGenericSets_New(t *testing.T) {
+	set1 := NewGenericSet[int]()
+	if set1.Size() != 0 || !set1.IsEmpty() {
+		t.Errorf("Expected empty set, got size %d", set1.Size())
+	}
+
+	set2 := NewGenericSet(1, 2, 3, 3)
+	if set2.Size() != 3 {
+		t.Errorf("Expected size 3, but got %d", set2.Size())
+	}
+	if !set2.Contains(1, 2, 3) {
+		t.Errorf("Expected set to contain 1, 2 and 3")
+	}
+}
+
+func TestGenericSets_AddRemove(t *testing.T) {
+	s := NewGenericSet[string]()
+	s.Add("apple", "banana")
+	s.AddAll([]string{"cherry", "apple"})
+	if s.Size() != 3 {
+		t.Errorf("Expected size 3, got %d", s.Size())
+	}
+
+	s.Remove("apple")
+	if s.Contains("apple") {
+		t.Errorf("Expected 'apple' to be removed")
+	}
+	s.RemoveAll([]string{"banana", "cherry"})
+	if !s.IsEmpty() {
+		t.Errorf("Expected set to be empty, got %v", s)
+	}
+}
+
+func TestGenericSets_ContainsAll(t *testing.T) {
+	s := NewGenericSet(1, 2, 3)
+	if !s.ContainsAll([]int{1, 3}) {
+		t.Errorf("Expected set to contain 1 and 3")
+	}
+	if s.ContainsAll([]int{1, 4}) {
+		t.Errorf("Expected set not to contain 4")
+	}
+}
+
+func TestGenericSets_Clear(t *testing.T) {
+	s := NewGenericSet(1, 2, 3)
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Errorf("Expected set to be empty after Clear")
+	}
+	s.Add(4)
+	if !s.Contains(4) {
+		t.Errorf("Expected set to be usable after Clear")
+	}
+}
+
+func TestGenericSets_Values(t *testing.T) {
+	s := NewGenericSet(3, 1, 2)
+	values := s.Values()
+	sort.Ints(values)
+	if len(values) != 3 || values[0] != 1 || values[1] != 2 || values[2] != 3 {
+		t.Errorf("Expected [1 2 3], got %v", values)
+	}
+}
+
+func TestGenericSets_SetOperations(t *testing.T) {
+	a := NewGenericSet(1, 2, 3)
+	b := NewGenericSet(2, 3, 4)
+
+	if got := a.Intersection(b); got.Size() != 2 || !got.Contains(2, 3) {
+		t.Errorf("Intersection: expected {2, 3}, got %v", got)
+	}
+	if got := a.Union(b); got.Size() != 4 || !got.Contains(1, 2, 3, 4) {
+		t.Errorf("Union: expected {1, 2, 3, 4}, got %v", got)
+	}
+	if got := a.Difference(b); got.Size() != 1 || !got.Contains(1) {
+		t.Errorf("Difference: expected {1}, got %v", got)
+	}
+	if a.Subset(b) {
+		t.Errorf("Expected a not to be a subset of b")
+	}
+	if !NewGenericSet(2, 3).Subset(a) {
+		t.Errorf("Expected {2, 3} to be a subset of a")
+	}
+}
+
+func TestGenericSets_String(t *testing.T) {
+	s := NewGenericSet("a")
+	expected := "Sets elements: [a]"
+	if s.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, s.String())
+	}
+}
@@ -22,5 +22,13 @@ type Set interface {
 	Difference(other *Sets) *Sets   // Return a new set containing elements in the current set but not in the other set
 	Subset(other *Sets) bool        // Check if the current set is a subset of the other set
 
+	Each(fn func(item interface{}) bool)                                                // Call fn for every element until it returns false
+	Filter(pred func(item interface{}) bool) *Sets                                      // Return a new set of the elements matching pred
+	Map(fn func(item interface{}) interface{}) *Sets                                    // Return a new set of fn applied to every element
+	Partition(pred func(item interface{}) bool) (*Sets, *Sets)                          // Split the set into matching and non-matching elements
+	Any(pred func(item interface{}) bool) bool                                          // Check if at least one element matches pred
+	All(pred func(item interface{}) bool) bool                                          // Check if every element matches pred
+	Reduce(initial interface{}, fn func(acc, item interface{}) interface{}) interface{} // Fold all elements into a single value
+
 	String() string // Return a string representation of the set
 }
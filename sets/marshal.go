@@ -0,0 +1,116 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package sets
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// MarshalJSON encodes the set as a JSON array of its elements in no particular order
+func (s *Sets) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Values())
+}
+
+// UnmarshalJSON decodes a JSON array, replacing the content of the set
+// Elements are decoded with the default encoding/json types, so numbers become float64.
+func (s *Sets) UnmarshalJSON(data []byte) error {
+	var values []interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	return s.replace(values)
+}
+
+// MarshalText encodes the set as its JSON representation
+func (s *Sets) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalText decodes text produced by MarshalText, replacing the content of the set
+func (s *Sets) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
+}
+
+// GobEncode encodes the elements of the set with encoding/gob
+// Concrete element types other than the gob built-ins must be registered with gob.Register.
+func (s *Sets) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.Values()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes data produced by GobEncode, replacing the content of the set
+func (s *Sets) GobDecode(data []byte) error {
+	var values []interface{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	return s.replace(values)
+}
+
+// replace swaps the content of the set for values, rejecting elements that cannot be map keys
+func (s *Sets) replace(values []interface{}) error {
+	items := make(map[interface{}]struct{}, len(values))
+	for _, value := range values {
+		if value != nil && !reflect.TypeOf(value).Comparable() {
+			return fmt.Errorf("sets: cannot use element of type %T as a set element", value)
+		}
+		items[value] = struct{}{}
+	}
+	s.items = items
+	return nil
+}
+
+// MarshalJSON encodes the set as a JSON array of its elements in no particular order
+func (s *GenericSets[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Values())
+}
+
+// UnmarshalJSON decodes a JSON array, replacing the content of the set
+func (s *GenericSets[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	s.items = make(map[T]struct{}, len(values))
+	s.Add(values...)
+	return nil
+}
+
+// MarshalText encodes the set as its JSON representation
+func (s *GenericSets[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalText decodes text produced by MarshalText, replacing the content of the set
+func (s *GenericSets[T]) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
+}
+
+// GobEncode encodes the elements of the set with encoding/gob
+func (s *GenericSets[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.Values()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes data produced by GobEncode, replacing the content of the set
+func (s *GenericSets[T]) GobDecode(data []byte) error {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	s.items = make(map[T]struct{}, len(values))
+	s.Add(values...)
+	return nil
+}
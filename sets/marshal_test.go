@@ -0,0 +1,137 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package sets
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+)
+
+func TestSets_MarshalJSON(t *testing.T) {
+	s := New("a", "b", 1.5)
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	decoded := New("stale")
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Size() != 3 || !decoded.Contains("a", "b", 1.5) {
+		t.Errorf("Expected %v after round trip, got %v", s, decoded)
+	}
+
+	if data, _ := json.Marshal(New()); string(data) != "[]" {
+		t.Errorf("Expected empty set to encode as [], got %s", data)
+	}
+
+	// Objects cannot be used as set elements
+	if err := json.Unmarshal([]byte(`[{"a": 1}]`), decoded); err == nil {
+		t.Errorf("Expected an error when decoding an unhashable element")
+	}
+}
+
+func TestSets_MarshalText(t *testing.T) {
+	s := New("x")
+	text, err := s.MarshalText()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	decoded := New()
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Size() != 1 || !decoded.Contains("x") {
+		t.Errorf("Expected %v after round trip, got %v", s, decoded)
+	}
+}
+
+func TestSets_GobEncode(t *testing.T) {
+	// Unlike JSON, which decodes numbers as float64, gob keeps the concrete element types
+	s := New(1, "two")
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := New()
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Size() != 2 || !decoded.Contains(1, "two") || decoded.Contains(1.0) {
+		t.Errorf("Expected %v after round trip, got %v", s, decoded)
+	}
+}
+
+func TestGenericSets_Marshal(t *testing.T) {
+	s := NewGenericSet(1, 2, 3)
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromJSON := NewGenericSet[int]()
+	if err := json.Unmarshal(data, fromJSON); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fromJSON.Size() != 3 || !fromJSON.Contains(1, 2, 3) {
+		t.Errorf("Expected %v after JSON round trip, got %v", s, fromJSON)
+	}
+
+	text, err := s.MarshalText()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromText := NewGenericSet[int]()
+	if err := fromText.UnmarshalText(text); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fromText.Size() != 3 || !fromText.Contains(1, 2, 3) {
+		t.Errorf("Expected %v after text round trip, got %v", s, fromText)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromGob := NewGenericSet[int]()
+	if err := gob.NewDecoder(&buf).Decode(fromGob); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fromGob.Size() != 3 || !fromGob.Contains(1, 2, 3) {
+		t.Errorf("Expected %v after gob round trip, got %v", s, fromGob)
+	}
+
+	if data, _ := json.Marshal(NewGenericSet[int]()); string(data) != "[]" {
+		t.Errorf("Expected empty set to encode as [], got %s", data)
+	}
+}
+
+func TestSets_Unmarshal(t *testing.T) {
+	// Decoding replaces the content of the set
+	decoded := New("stale")
+	if err := json.Unmarshal([]byte(`["a", "b", "a"]`), decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Size() != 2 || !decoded.Contains("a", "b") || decoded.Contains("stale") {
+		t.Errorf("Expected {a, b}, got %v", decoded)
+	}
+
+	// Objects cannot be used as set elements, the set is left as it was
+	if err := json.Unmarshal([]byte(`[1, {"a": 1}]`), decoded); err == nil {
+		t.Errorf("Expected an error when decoding an unhashable element")
+	}
+	if decoded.Size() != 2 || decoded.Contains(1.0) {
+		t.Errorf("Expected the set to be untouched after a failed decode, got %v", decoded)
+	}
+
+	generic := NewGenericSet("stale")
+	if err := generic.UnmarshalText([]byte(`["x"]`)); err != nil || generic.Size() != 1 || !generic.Contains("x") {
+		t.Errorf("Expected {x}, got %v (%v)", generic, err)
+	}
+}
@@ -8,3 +8,8 @@ package sets
 type Sets struct {
 	items map[interface{}]struct{}
 }
+
+// GenericSets defines a collection type with a generic element type implemented using a hash map.
+type GenericSets[T comparable] struct {
+	items map[T]struct{}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package array
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON encodes the stack as a JSON array ordered from bottom to top
+// An empty stack encodes as [] rather than null.
+func (s *Stacks) MarshalJSON() ([]byte, error) {
+	return json.Marshal(append([]interface{}{}, s.items...))
+}
+
+// UnmarshalJSON decodes a JSON array, replacing the content of the stack
+// Elements are decoded with the default encoding/json types, so numbers become float64.
+func (s *Stacks) UnmarshalJSON(data []byte) error {
+	var values []interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	s.items = values
+	return nil
+}
+
+// MarshalText encodes the stack as its JSON representation
+func (s *Stacks) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalText decodes text produced by MarshalText, replacing the content of the stack
+func (s *Stacks) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
+}
+
+// GobEncode encodes the elements of the stack with encoding/gob
+// Concrete element types other than the gob built-ins must be registered with gob.Register.
+func (s *Stacks) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.items); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes data produced by GobEncode, replacing the content of the stack
+func (s *Stacks) GobDecode(data []byte) error {
+	var values []interface{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	s.items = values
+	return nil
+}
+
+// MarshalJSON encodes the stack as a JSON array ordered from bottom to top
+// An empty stack encodes as [] rather than null.
+func (s *GenericStacks[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(append([]T{}, s.items...))
+}
+
+// UnmarshalJSON decodes a JSON array, replacing the content of the stack
+func (s *GenericStacks[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	s.items = values
+	return nil
+}
+
+// MarshalText encodes the stack as its JSON representation
+func (s *GenericStacks[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalText decodes text produced by MarshalText, replacing the content of the stack
+func (s *GenericStacks[T]) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
+}
+
+// GobEncode encodes the elements of the stack with encoding/gob
+func (s *GenericStacks[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.items); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes data produced by GobEncode, replacing the content of the stack
+func (s *GenericStacks[T]) GobDecode(data []byte) error {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	s.items = values
+	return nil
+}
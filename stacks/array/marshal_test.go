@@ -0,0 +1,119 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package array
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestStack_MarshalJSON(t *testing.T) {
+	s := New()
+	s.Push("a", "b", "c")
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != `["a","b","c"]` {
+		t.Errorf(`Expected ["a","b","c"], got %s`, data)
+	}
+
+	// Decoding replaces the content of the stack
+	decoded := New()
+	decoded.Push("stale")
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Pop() != "c" || decoded.Size() != 2 {
+		t.Errorf("Expected stack order to survive the round trip and stale elements to be dropped, got %v", decoded)
+	}
+
+	if data, _ := json.Marshal(New()); string(data) != "[]" {
+		t.Errorf("Expected empty stack to encode as [], got %s", data)
+	}
+}
+
+func TestStack_MarshalText(t *testing.T) {
+	s := New()
+	s.Push("x", "y")
+	text, err := s.MarshalText()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	decoded := New()
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.Values(), s.Values()) {
+		t.Errorf("Expected %v after round trip, got %v", s, decoded)
+	}
+}
+
+func TestStack_GobEncode(t *testing.T) {
+	s := New()
+	s.Push(1, "two", 3.0)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := New()
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.Values(), s.Values()) {
+		t.Errorf("Expected %v after round trip, got %v", s, decoded)
+	}
+}
+
+func TestGenericStacks_Marshal(t *testing.T) {
+	s := NewGenericStack[int]()
+	s.Push(1, 2, 3)
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromJSON := NewGenericStack[int]()
+	if err := json.Unmarshal(data, fromJSON); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fromJSON.Values(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] after JSON round trip, got %v", fromJSON.Values())
+	}
+
+	text, err := s.MarshalText()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromText := NewGenericStack[int]()
+	if err := fromText.UnmarshalText(text); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fromText.Values(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] after text round trip, got %v", fromText.Values())
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromGob := NewGenericStack[int]()
+	if err := gob.NewDecoder(&buf).Decode(fromGob); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fromGob.Values(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] after gob round trip, got %v", fromGob.Values())
+	}
+
+	if data, _ := json.Marshal(NewGenericStack[int]()); string(data) != "[]" {
+		t.Errorf("Expected empty stack to encode as [], got %s", data)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package avltree
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON encodes the AVL tree as a JSON array of {"key", "value"} objects in key order
+// An array of pairs is used instead of an object so that keys of any type can be encoded.
+func (t *Tree[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.pairs())
+}
+
+// UnmarshalJSON decodes a JSON array of key-value pairs, replacing the content of the AVL tree
+func (t *Tree[K, V]) UnmarshalJSON(data []byte) error {
+	var pairs []pair[K, V]
+	if err := json.Unmarshal(data, &pairs); err != nil {
+		return err
+	}
+	t.replace(pairs)
+	return nil
+}
+
+// MarshalText encodes the AVL tree as its JSON representation
+func (t *Tree[K, V]) MarshalText() ([]byte, error) {
+	return t.MarshalJSON()
+}
+
+// UnmarshalText decodes text produced by MarshalText, replacing the content of the AVL tree
+func (t *Tree[K, V]) UnmarshalText(text []byte) error {
+	return t.UnmarshalJSON(text)
+}
+
+// GobEncode encodes the key-value pairs of the AVL tree with encoding/gob
+func (t *Tree[K, V]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(t.pairs()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes data produced by GobEncode, replacing the content of the AVL tree
+func (t *Tree[K, V]) GobDecode(data []byte) error {
+	var pairs []pair[K, V]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&pairs); err != nil {
+		return err
+	}
+	t.replace(pairs)
+	return nil
+}
+
+// pairs returns the key-value pairs of the AVL tree in key order
+func (t *Tree[K, V]) pairs() []pair[K, V] {
+	pairs := make([]pair[K, V], 0, t.size)
+	t.collectPairs(t.root, &pairs)
+	return pairs
+}
+
+// collectPairs is a recursive helper function that appends the pairs of a subtree in key order.
+func (t *Tree[K, V]) collectPairs(node *Node[K, V], pairs *[]pair[K, V]) {
+	if node == nil {
+		return
+	}
+	t.collectPairs(node.left, pairs)
+	*pairs = append(*pairs, pair[K, V]{Key: node.key, Value: node.value})
+	t.collectPairs(node.right, pairs)
+}
+
+// replace drops all nodes of the AVL tree and inserts the given pairs
+func (t *Tree[K, V]) replace(pairs []pair[K, V]) {
+	t.root, t.size = nil, 0
+	for _, p := range pairs {
+		t.Put(p.Key, p.Value)
+	}
+}
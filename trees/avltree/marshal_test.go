@@ -0,0 +1,80 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package avltree
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestTree_MarshalJSON(t *testing.T) {
+	tree := New[int, string]()
+	tree.Put(2, "two")
+	tree.Put(1, "one")
+	tree.Put(3, "three")
+
+	// Entries are encoded as key/value pairs in key order, whatever the insertion order
+	data, err := json.Marshal(tree)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `[{"key":1,"value":"one"},{"key":2,"value":"two"},{"key":3,"value":"three"}]`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	// Decoding replaces the content of the tree
+	decoded := New[int, string]()
+	decoded.Put(9, "stale")
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.InOrder(), tree.InOrder()) || decoded.Contains(9) {
+		t.Errorf("Expected %v after round trip, got %v", tree.InOrder(), decoded.InOrder())
+	}
+
+	if data, _ := json.Marshal(New[int, string]()); string(data) != "[]" {
+		t.Errorf("Expected empty tree to encode as [], got %s", data)
+	}
+}
+
+func TestTree_MarshalText(t *testing.T) {
+	tree := New[string, int]()
+	tree.Put("a", 1)
+	text, err := tree.MarshalText()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	decoded := New[string, int]()
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if value, ok := decoded.Get("a"); !ok || value != 1 || decoded.Len() != 1 {
+		t.Errorf("Expected {a: 1} after round trip, got %v", decoded.InOrder())
+	}
+}
+
+func TestTree_GobEncode(t *testing.T) {
+	tree := New[int, string]()
+	for i, v := range []string{"zero", "one", "two", "three", "four"} {
+		tree.Put(i, v)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(tree); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := New[int, string]()
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.InOrder(), tree.InOrder()) || decoded.Len() != 5 {
+		t.Errorf("Expected %v after round trip, got %v", tree.InOrder(), decoded.InOrder())
+	}
+}
@@ -18,3 +18,9 @@ type Node[K comparable, V any] struct {
 	left   *Node[K, V] // Left child node
 	right  *Node[K, V] // Right child node
 }
+
+// pair is a key-value pair used to encode the AVL tree
+type pair[K comparable, V any] struct {
+	Key   K `json:"key"`   // Key of the node
+	Value V `json:"value"` // Value of the node
+}
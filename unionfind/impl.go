@@ -0,0 +1,158 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// Reference: https://en.wikipedia.org/wiki/Disjoint-set_data_structure
+
+package unionfind
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethan-gao-code/go-ds/sets"
+)
+
+// New creates a new union-find structure with each given element in its own group
+func New[T comparable](elements ...T) *UnionFind[T] {
+	uf := &UnionFind[T]{index: make(map[T]int, len(elements))}
+	uf.Add(elements...)
+	return uf
+}
+
+// Add adds elements, each in its own group
+// Elements that are already present are left untouched
+func (uf *UnionFind[T]) Add(elements ...T) {
+	for _, element := range elements {
+		uf.add(element)
+	}
+}
+
+// Contains checks if the element has been added
+func (uf *UnionFind[T]) Contains(element T) bool {
+	_, exists := uf.index[element]
+	return exists
+}
+
+// Find returns the representative element of the group containing element
+// The second return value is false if the element has not been added
+func (uf *UnionFind[T]) Find(element T) (T, bool) {
+	i, exists := uf.index[element]
+	if !exists {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return uf.elements[uf.find(i)], true
+}
+
+// Union merges the groups containing a and b, adding either element if it is missing
+// It returns false if a and b were already in the same group
+func (uf *UnionFind[T]) Union(a, b T) bool {
+	rootA, rootB := uf.find(uf.add(a)), uf.find(uf.add(b))
+	if rootA == rootB {
+		return false
+	}
+
+	// Union by size: attach the smaller tree below the larger one
+	if uf.size[rootA] < uf.size[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	uf.parent[rootB] = rootA
+	uf.size[rootA] += uf.size[rootB]
+	uf.groups--
+	return true
+}
+
+// Connected checks if a and b are in the same group
+// Elements that have not been added are not connected to anything
+func (uf *UnionFind[T]) Connected(a, b T) bool {
+	i, existsA := uf.index[a]
+	j, existsB := uf.index[b]
+	if !existsA || !existsB {
+		return false
+	}
+	return uf.find(i) == uf.find(j)
+}
+
+// SetSize returns the number of elements in the group containing element
+// It returns 0 if the element has not been added
+func (uf *UnionFind[T]) SetSize(element T) int {
+	i, exists := uf.index[element]
+	if !exists {
+		return 0
+	}
+	return uf.size[uf.find(i)]
+}
+
+// Groups returns every group as a set, in the order their first element was added
+func (uf *UnionFind[T]) Groups() []*sets.GenericSets[T] {
+	groups := make([]*sets.GenericSets[T], 0, uf.groups)
+	position := make(map[int]int, uf.groups) // Position of each root's group in groups
+	for i, element := range uf.elements {
+		root := uf.find(i)
+		p, exists := position[root]
+		if !exists {
+			p = len(groups)
+			position[root] = p
+			groups = append(groups, sets.NewGenericSet[T]())
+		}
+		groups[p].Add(element)
+	}
+	return groups
+}
+
+// Size returns the number of elements
+func (uf *UnionFind[T]) Size() int {
+	return len(uf.elements)
+}
+
+// Count returns the number of disjoint groups
+func (uf *UnionFind[T]) Count() int {
+	return uf.groups
+}
+
+// IsEmpty checks if no element has been added
+func (uf *UnionFind[T]) IsEmpty() bool {
+	return len(uf.elements) == 0
+}
+
+// String returns a string representation of the groups
+func (uf *UnionFind[T]) String() string {
+	// Create a slice to hold string representations of the groups
+	var groups []string
+	for _, group := range uf.Groups() {
+		var elements []string
+		group.Each(func(item T) bool {
+			elements = append(elements, fmt.Sprintf("%v", item))
+			return true
+		})
+		groups = append(groups, fmt.Sprintf("{%s}", strings.Join(elements, ", ")))
+	}
+
+	// Join all the groups with commas and wrap them in square brackets
+	return fmt.Sprintf("UnionFind groups: [%s]", strings.Join(groups, ", "))
+}
+
+// add adds element in its own group if it is missing and returns its index
+func (uf *UnionFind[T]) add(element T) int {
+	if i, exists := uf.index[element]; exists {
+		return i
+	}
+	i := len(uf.elements)
+	uf.index[element] = i
+	uf.elements = append(uf.elements, element)
+	uf.parent = append(uf.parent, i)
+	uf.size = append(uf.size, 1)
+	uf.groups++
+	return i
+}
+
+// find returns the root index of i, compressing the path along the way
+func (uf *UnionFind[T]) find(i int) int {
+	// Path halving: point every other node on the path to its grandparent
+	for uf.parent[i] != i {
+		uf.parent[i] = uf.parent[uf.parent[i]]
+		i = uf.parent[i]
+	}
+	return i
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package unionfind
+
+import (
+	"testing"
+)
+
+func TestUnionFind_New(t *testing.T) {
+	uf := New[int]()
+	if !uf.IsEmpty() || uf.Size() != 0 || uf.Count() != 0 {
+		t.Errorf("Expected empty union-find, got %v", uf)
+	}
+
+	uf = New(1, 2, 3, 3)
+	if uf.Size() != 3 || uf.Count() != 3 {
+		t.Errorf("Expected 3 elements in 3 groups, got %d in %d", uf.Size(), uf.Count())
+	}
+}
+
+func TestUnionFind_Add(t *testing.T) {
+	uf := New("a")
+	uf.Union("a", "b")
+	uf.Add("a", "c")
+
+	// Adding an existing element must not split its group
+	if !uf.Connected("a", "b") {
+		t.Errorf("Expected a and b to stay connected after re-adding a")
+	}
+	if !uf.Contains("c") || uf.Contains("d") {
+		t.Errorf("Expected c to be present and d to be missing")
+	}
+	if uf.Count() != 2 {
+		t.Errorf("Expected 2 groups, got %d", uf.Count())
+	}
+}
+
+func TestUnionFind_Union(t *testing.T) {
+	uf := New(1, 2, 3, 4)
+
+	if !uf.Union(1, 2) {
+		t.Errorf("Expected Union(1, 2) to merge two groups")
+	}
+	if uf.Union(2, 1) {
+		t.Errorf("Expected Union(2, 1) to report that they were already merged")
+	}
+	if !uf.Union(3, 4) || !uf.Union(1, 4) {
+		t.Errorf("Expected Union(3, 4) and Union(1, 4) to merge groups")
+	}
+	if uf.Count() != 1 || uf.SetSize(3) != 4 {
+		t.Errorf("Expected a single group of 4, got %d groups, size %d", uf.Count(), uf.SetSize(3))
+	}
+
+	// Union adds missing elements
+	if !uf.Union(5, 6) || uf.Size() != 6 || uf.Count() != 2 {
+		t.Errorf("Expected Union(5, 6) to add both elements in a new group, got %v", uf)
+	}
+}
+
+func TestUnionFind_Find(t *testing.T) {
+	uf := New("a", "b", "c")
+	uf.Union("a", "b")
+
+	rootA, okA := uf.Find("a")
+	rootB, okB := uf.Find("b")
+	if !okA || !okB || rootA != rootB {
+		t.Errorf("Expected a and b to share a representative, got %v and %v", rootA, rootB)
+	}
+	if root, ok := uf.Find("c"); !ok || root != "c" {
+		t.Errorf("Expected c to be its own representative, got %v", root)
+	}
+	if _, ok := uf.Find("missing"); ok {
+		t.Errorf("Expected Find on a missing element to fail")
+	}
+}
+
+func TestUnionFind_Connected(t *testing.T) {
+	uf := New(1, 2, 3)
+	uf.Union(1, 2)
+
+	if !uf.Connected(1, 2) || uf.Connected(1, 3) {
+		t.Errorf("Expected 1-2 to be connected and 1-3 not to be")
+	}
+	if uf.Connected(1, 99) || uf.Connected(99, 99) {
+		t.Errorf("Expected missing elements not to be connected")
+	}
+}
+
+func TestUnionFind_SetSize(t *testing.T) {
+	uf := New(1, 2, 3)
+	uf.Union(1, 2)
+
+	if uf.SetSize(1) != 2 || uf.SetSize(3) != 1 || uf.SetSize(99) != 0 {
+		t.Errorf("Unexpected set sizes %d, %d, %d", uf.SetSize(1), uf.SetSize(3), uf.SetSize(99))
+	}
+}
+
+func TestUnionFind_Groups(t *testing.T) {
+	uf := New(1, 2, 3, 4, 5)
+	uf.Union(1, 3)
+	uf.Union(2, 5)
+
+	groups := uf.Groups()
+	if len(groups) != 3 {
+		t.Fatalf("Expected 3 groups, got %d", len(groups))
+	}
+	// Groups are ordered by the first element added to each of them
+	if groups[0].Size() != 2 || !groups[0].Contains(1, 3) {
+		t.Errorf("Expected first group {1, 3}, got %v", groups[0])
+	}
+	if groups[1].Size() != 2 || !groups[1].Contains(2, 5) {
+		t.Errorf("Expected second group {2, 5}, got %v", groups[1])
+	}
+	if groups[2].Size() != 1 || !groups[2].Contains(4) {
+		t.Errorf("Expected third group {4}, got %v", groups[2])
+	}
+}
+
+func TestUnionFind_LongChain(t *testing.T) {
+	const n = 10000
+	uf := New[int]()
+	for i := 1; i < n; i++ {
+		uf.Union(i-1, i)
+	}
+
+	if uf.Count() != 1 || uf.SetSize(0) != n {
+		t.Errorf("Expected one group of %d, got %d groups, size %d", n, uf.Count(), uf.SetSize(0))
+	}
+	if !uf.Connected(0, n-1) {
+		t.Errorf("Expected both ends of the chain to be connected")
+	}
+}
+
+func TestUnionFind_String(t *testing.T) {
+	uf := New(1, 2, 3)
+	uf.Union(1, 2)
+
+	expected := "UnionFind groups: [{1, 2}, {3}]"
+	alternative := "UnionFind groups: [{2, 1}, {3}]"
+	if s := uf.String(); s != expected && s != alternative {
+		t.Errorf("Expected %s, got %s", expected, s)
+	}
+}
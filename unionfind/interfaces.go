@@ -0,0 +1,21 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+// Reference: https://en.wikipedia.org/wiki/Disjoint-set_data_structure
+
+package unionfind
+
+// DisjointSet defines the operations that a disjoint-set structure should support
+type DisjointSet[T comparable] interface {
+	Add(elements ...T)        // Add elements, each in its own group
+	Contains(element T) bool  // Check if the element has been added
+	Find(element T) (T, bool) // Return the representative of the element's group
+	Union(a, b T) bool        // Merge the groups of a and b, adding them if needed
+	Connected(a, b T) bool    // Check if a and b are in the same group
+	SetSize(element T) int    // Return the size of the element's group
+	Size() int                // Return the number of elements
+	Count() int               // Return the number of groups
+	IsEmpty() bool            // Check if there are no elements
+	String() string           // Return a string representation of the groups
+}
@@ -0,0 +1,15 @@
+// Copyright (c) 2025 EthanGao
+// This file is part of a project licensed under the MIT License.
+// License that can be found in the LICENSE file.
+
+package unionfind
+
+// UnionFind represents a disjoint-set forest over elements of type T
+// Elements are mapped to dense indices so the forest itself is stored in slices.
+type UnionFind[T comparable] struct {
+	index    map[T]int // Index of each element in the slices below
+	elements []T       // Element stored at each index
+	parent   []int     // Parent index of each element, roots point to themselves
+	size     []int     // Number of elements in the group, only meaningful for roots
+	groups   int       // Number of disjoint groups
+}